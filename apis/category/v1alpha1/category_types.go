@@ -36,15 +36,36 @@ type CategoryParameters struct {
 	Name             string             `json:"name,omitempty"`
 	IsActive         bool               `json:"isActive,omitempty"`
 	Position         int                `json:"position,omitempty"`
-	Level            int                `json:"level,omitempty"`
 	Children         string             `json:"children,omitempty"`
 	CreatedAt        string             `json:"createdAt,omitempty"`
 	UpdatedAt        string             `json:"updatedAt,omitempty"`
-	Path             string             `json:"path,omitempty"`
 	AvailableSortBy  []string           `json:"availableSortBy,omitempty"`
 	IncludeInMenu    bool               `json:"includeInMenu,omitempty"`
 	CustomAttributes []CustomAttributes `json:"customAttributes,omitempty"`
-	ParentID         int                `json:"parentId,omitempty"`
+	// ParentID of the category. The category is moved when it changes.
+	// It is a string, so IDs declared as numbers have to be quoted.
+	// +crossplane:generate:reference:type=Category
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +optional
+	ParentID string `json:"parentId,omitempty"`
+	// ParentIDRef references a Category to retrieve its ID.
+	// +optional
+	ParentIDRef *xpv1.Reference `json:"parentIdRef,omitempty"`
+	// ParentIDSelector selects a reference to a Category to retrieve its ID.
+	// +optional
+	ParentIDSelector *xpv1.Selector `json:"parentIdSelector,omitempty"`
+	// OnDelete decides what happens to a category with children or
+	// products when the managed resource is deleted. Block refuses to
+	// delete it, Orphan leaves it in place and Cascade deletes it along with
+	// its children. Root categories are never deleted.
+	// +kubebuilder:validation:Enum=Block;Orphan;Cascade
+	// +kubebuilder:default=Block
+	// +optional
+	OnDelete string `json:"onDelete,omitempty"`
+	// AfterID is the sibling the category is placed after when it is moved.
+	// By default it is placed last.
+	// +optional
+	AfterID *int `json:"afterId,omitempty"`
 }
 
 // CategoryObservation are the observable fields of a Category.
@@ -55,6 +76,7 @@ type CategoryObservation struct {
 	IsActive     bool   `json:"isActive,omitempty"`
 	Position     int    `json:"position,omitempty"`
 	Level        int    `json:"level,omitempty"`
+	Path         string `json:"path,omitempty"`
 	ProductCount int    `json:"productCount,omitempty"`
 }
 
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -112,6 +113,21 @@ func (in *CategoryParameters) DeepCopyInto(out *CategoryParameters) {
 		*out = make([]CustomAttributes, len(*in))
 		copy(*out, *in)
 	}
+	if in.ParentIDRef != nil {
+		in, out := &in.ParentIDRef, &out.ParentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParentIDSelector != nil {
+		in, out := &in.ParentIDSelector, &out.ParentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AfterID != nil {
+		in, out := &in.AfterID, &out.AfterID
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryParameters.
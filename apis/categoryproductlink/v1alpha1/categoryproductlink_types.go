@@ -0,0 +1,113 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CategoryProduct is a product assigned to a category.
+type CategoryProduct struct {
+	Sku string `json:"sku"`
+	// Position of the product within the category.
+	// +optional
+	Position int `json:"position"`
+}
+
+// CategoryProductLinkParameters are the configurable fields of a
+// CategoryProductLink.
+type CategoryProductLinkParameters struct {
+	// CategoryID of the category the products are assigned to.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/category/v1alpha1.Category
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="categoryId is immutable"
+	// +optional
+	CategoryID string `json:"categoryId,omitempty"`
+	// CategoryIDRef references a Category to retrieve its ID.
+	// +optional
+	CategoryIDRef *xpv1.Reference `json:"categoryIdRef,omitempty"`
+	// CategoryIDSelector selects a reference to a Category to retrieve its
+	// ID.
+	// +optional
+	CategoryIDSelector *xpv1.Selector `json:"categoryIdSelector,omitempty"`
+	// Products is the complete set of products of the category. Products
+	// not listed are unassigned from the category.
+	// +optional
+	Products []CategoryProduct `json:"products,omitempty"`
+}
+
+// CategoryProductLinkObservation are the observable fields of a
+// CategoryProductLink.
+type CategoryProductLinkObservation struct {
+	Products []CategoryProduct `json:"products,omitempty"`
+}
+
+// A CategoryProductLinkSpec defines the desired state of a CategoryProductLink.
+type CategoryProductLinkSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CategoryProductLinkParameters `json:"forProvider"`
+}
+
+// A CategoryProductLinkStatus represents the observed state of a CategoryProductLink.
+type CategoryProductLinkStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CategoryProductLinkObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CategoryProductLink is the complete set of products assigned to a
+// category.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type CategoryProductLink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CategoryProductLinkSpec   `json:"spec"`
+	Status CategoryProductLinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CategoryProductLinkList contains a list of CategoryProductLink
+type CategoryProductLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CategoryProductLink `json:"items"`
+}
+
+// CategoryProductLink type metadata.
+var (
+	CategoryProductLinkKind             = reflect.TypeOf(CategoryProductLink{}).Name()
+	CategoryProductLinkGroupKind        = schema.GroupKind{Group: Group, Kind: CategoryProductLinkKind}.String()
+	CategoryProductLinkKindAPIVersion   = CategoryProductLinkKind + "." + SchemeGroupVersion.String()
+	CategoryProductLinkGroupVersionKind = SchemeGroupVersion.WithKind(CategoryProductLinkKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CategoryProductLink{}, &CategoryProductLinkList{})
+}
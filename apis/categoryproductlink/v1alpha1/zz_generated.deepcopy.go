@@ -0,0 +1,185 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryProduct) DeepCopyInto(out *CategoryProduct) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryProduct.
+func (in *CategoryProduct) DeepCopy() *CategoryProduct {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryProduct)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryProductLink) DeepCopyInto(out *CategoryProductLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryProductLink.
+func (in *CategoryProductLink) DeepCopy() *CategoryProductLink {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryProductLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CategoryProductLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryProductLinkList) DeepCopyInto(out *CategoryProductLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CategoryProductLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryProductLinkList.
+func (in *CategoryProductLinkList) DeepCopy() *CategoryProductLinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryProductLinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CategoryProductLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryProductLinkObservation) DeepCopyInto(out *CategoryProductLinkObservation) {
+	*out = *in
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]CategoryProduct, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryProductLinkObservation.
+func (in *CategoryProductLinkObservation) DeepCopy() *CategoryProductLinkObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryProductLinkObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryProductLinkParameters) DeepCopyInto(out *CategoryProductLinkParameters) {
+	*out = *in
+	if in.CategoryIDRef != nil {
+		in, out := &in.CategoryIDRef, &out.CategoryIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CategoryIDSelector != nil {
+		in, out := &in.CategoryIDSelector, &out.CategoryIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]CategoryProduct, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryProductLinkParameters.
+func (in *CategoryProductLinkParameters) DeepCopy() *CategoryProductLinkParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryProductLinkParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryProductLinkSpec) DeepCopyInto(out *CategoryProductLinkSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryProductLinkSpec.
+func (in *CategoryProductLinkSpec) DeepCopy() *CategoryProductLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryProductLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryProductLinkStatus) DeepCopyInto(out *CategoryProductLinkStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryProductLinkStatus.
+func (in *CategoryProductLinkStatus) DeepCopy() *CategoryProductLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryProductLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
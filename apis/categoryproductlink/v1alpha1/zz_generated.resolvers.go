@@ -0,0 +1,53 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	v1alpha11 "github.com/web-seven/provider-magento/apis/category/v1alpha1"
+	v1alpha1 "github.com/web-seven/provider-magento/apis/v1alpha1"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this CategoryProductLink.
+func (mg *CategoryProductLink) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.CategoryID,
+		Extract:      v1alpha1.ExternalID(),
+		Reference:    mg.Spec.ForProvider.CategoryIDRef,
+		Selector:     mg.Spec.ForProvider.CategoryIDSelector,
+		To: reference.To{
+			List:    &v1alpha11.CategoryList{},
+			Managed: &v1alpha11.Category{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CategoryID")
+	}
+	mg.Spec.ForProvider.CategoryID = rsp.ResolvedValue
+	mg.Spec.ForProvider.CategoryIDRef = rsp.ResolvedReference
+
+	return nil
+}
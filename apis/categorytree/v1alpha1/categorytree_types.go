@@ -0,0 +1,170 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CategoryTreeNodeFields are the fields of a category in a CategoryTree.
+type CategoryTreeNodeFields struct {
+	// Name of the category, which identifies it among its siblings.
+	Name string `json:"name"`
+	// +kubebuilder:default=true
+	// +optional
+	IsActive *bool `json:"isActive,omitempty"`
+	// +kubebuilder:default=true
+	// +optional
+	IncludeInMenu *bool `json:"includeInMenu,omitempty"`
+}
+
+// CategoryTreeNode is a category directly below the root category. The
+// position of categories follows the order they are listed in.
+type CategoryTreeNode struct {
+	CategoryTreeNodeFields `json:",inline"`
+	// +optional
+	Children []CategoryTreeNode2 `json:"children,omitempty"`
+}
+
+// CategoryTreeNode2 is a category on the second level below the root.
+type CategoryTreeNode2 struct {
+	CategoryTreeNodeFields `json:",inline"`
+	// +optional
+	Children []CategoryTreeNode3 `json:"children,omitempty"`
+}
+
+// CategoryTreeNode3 is a category on the third level below the root.
+type CategoryTreeNode3 struct {
+	CategoryTreeNodeFields `json:",inline"`
+	// +optional
+	Children []CategoryTreeNode4 `json:"children,omitempty"`
+}
+
+// CategoryTreeNode4 is a category on the fourth level below the root.
+type CategoryTreeNode4 struct {
+	CategoryTreeNodeFields `json:",inline"`
+	// +optional
+	Children []CategoryTreeNode5 `json:"children,omitempty"`
+}
+
+// CategoryTreeNode5 is a category on the fifth and deepest level below the
+// root which can be declared.
+type CategoryTreeNode5 struct {
+	CategoryTreeNodeFields `json:",inline"`
+}
+
+// CategoryTreeParameters are the configurable fields of a CategoryTree.
+type CategoryTreeParameters struct {
+	// RootCategoryID of the category the tree is declared below.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/category/v1alpha1.Category
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="rootCategoryId is immutable"
+	// +optional
+	RootCategoryID string `json:"rootCategoryId,omitempty"`
+	// RootCategoryIDRef references a Category to retrieve its ID.
+	// +optional
+	RootCategoryIDRef *xpv1.Reference `json:"rootCategoryIdRef,omitempty"`
+	// RootCategoryIDSelector selects a reference to a Category to retrieve
+	// its ID.
+	// +optional
+	RootCategoryIDSelector *xpv1.Selector `json:"rootCategoryIdSelector,omitempty"`
+	// Categories below the root category.
+	// +optional
+	Categories []CategoryTreeNode `json:"categories,omitempty"`
+	// Prune deletes categories below the root category which are not
+	// declared, along with their children. A pruned tree also moves
+	// categories declared below another parent than they are found below,
+	// while a tree which is not pruned creates them and leaves the others.
+	// +optional
+	Prune bool `json:"prune,omitempty"`
+	// OnDelete decides what happens to declared categories with products or
+	// undeclared children when the managed resource is deleted. Block
+	// refuses to delete the tree, Orphan leaves them in place and Cascade
+	// deletes them along with their children. Root categories are never
+	// deleted.
+	// +kubebuilder:validation:Enum=Block;Orphan;Cascade
+	// +kubebuilder:default=Block
+	// +optional
+	OnDelete string `json:"onDelete,omitempty"`
+}
+
+// ObservedCategory is a declared category found in Magento.
+type ObservedCategory struct {
+	// Path of names from the root category, separated by slashes.
+	Path string `json:"path"`
+	ID   int    `json:"id"`
+}
+
+// CategoryTreeObservation are the observable fields of a CategoryTree.
+type CategoryTreeObservation struct {
+	Categories []ObservedCategory `json:"categories,omitempty"`
+}
+
+// A CategoryTreeSpec defines the desired state of a CategoryTree.
+type CategoryTreeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CategoryTreeParameters `json:"forProvider"`
+}
+
+// A CategoryTreeStatus represents the observed state of a CategoryTree.
+type CategoryTreeStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CategoryTreeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CategoryTree is a hierarchy of categories below a root category.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type CategoryTree struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CategoryTreeSpec   `json:"spec"`
+	Status CategoryTreeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CategoryTreeList contains a list of CategoryTree
+type CategoryTreeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CategoryTree `json:"items"`
+}
+
+// CategoryTree type metadata.
+var (
+	CategoryTreeKind             = reflect.TypeOf(CategoryTree{}).Name()
+	CategoryTreeGroupKind        = schema.GroupKind{Group: Group, Kind: CategoryTreeKind}.String()
+	CategoryTreeKindAPIVersion   = CategoryTreeKind + "." + SchemeGroupVersion.String()
+	CategoryTreeGroupVersionKind = SchemeGroupVersion.WithKind(CategoryTreeKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CategoryTree{}, &CategoryTreeList{})
+}
@@ -0,0 +1,320 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTree) DeepCopyInto(out *CategoryTree) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTree.
+func (in *CategoryTree) DeepCopy() *CategoryTree {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTree)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CategoryTree) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeList) DeepCopyInto(out *CategoryTreeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CategoryTree, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeList.
+func (in *CategoryTreeList) DeepCopy() *CategoryTreeList {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CategoryTreeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeNode) DeepCopyInto(out *CategoryTreeNode) {
+	*out = *in
+	in.CategoryTreeNodeFields.DeepCopyInto(&out.CategoryTreeNodeFields)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]CategoryTreeNode2, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeNode.
+func (in *CategoryTreeNode) DeepCopy() *CategoryTreeNode {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeNode2) DeepCopyInto(out *CategoryTreeNode2) {
+	*out = *in
+	in.CategoryTreeNodeFields.DeepCopyInto(&out.CategoryTreeNodeFields)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]CategoryTreeNode3, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeNode2.
+func (in *CategoryTreeNode2) DeepCopy() *CategoryTreeNode2 {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeNode2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeNode3) DeepCopyInto(out *CategoryTreeNode3) {
+	*out = *in
+	in.CategoryTreeNodeFields.DeepCopyInto(&out.CategoryTreeNodeFields)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]CategoryTreeNode4, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeNode3.
+func (in *CategoryTreeNode3) DeepCopy() *CategoryTreeNode3 {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeNode3)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeNode4) DeepCopyInto(out *CategoryTreeNode4) {
+	*out = *in
+	in.CategoryTreeNodeFields.DeepCopyInto(&out.CategoryTreeNodeFields)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]CategoryTreeNode5, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeNode4.
+func (in *CategoryTreeNode4) DeepCopy() *CategoryTreeNode4 {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeNode4)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeNode5) DeepCopyInto(out *CategoryTreeNode5) {
+	*out = *in
+	in.CategoryTreeNodeFields.DeepCopyInto(&out.CategoryTreeNodeFields)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeNode5.
+func (in *CategoryTreeNode5) DeepCopy() *CategoryTreeNode5 {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeNode5)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeNodeFields) DeepCopyInto(out *CategoryTreeNodeFields) {
+	*out = *in
+	if in.IsActive != nil {
+		in, out := &in.IsActive, &out.IsActive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeInMenu != nil {
+		in, out := &in.IncludeInMenu, &out.IncludeInMenu
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeNodeFields.
+func (in *CategoryTreeNodeFields) DeepCopy() *CategoryTreeNodeFields {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeNodeFields)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeObservation) DeepCopyInto(out *CategoryTreeObservation) {
+	*out = *in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]ObservedCategory, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeObservation.
+func (in *CategoryTreeObservation) DeepCopy() *CategoryTreeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeParameters) DeepCopyInto(out *CategoryTreeParameters) {
+	*out = *in
+	if in.RootCategoryIDRef != nil {
+		in, out := &in.RootCategoryIDRef, &out.RootCategoryIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootCategoryIDSelector != nil {
+		in, out := &in.RootCategoryIDSelector, &out.RootCategoryIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]CategoryTreeNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeParameters.
+func (in *CategoryTreeParameters) DeepCopy() *CategoryTreeParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeSpec) DeepCopyInto(out *CategoryTreeSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeSpec.
+func (in *CategoryTreeSpec) DeepCopy() *CategoryTreeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryTreeStatus) DeepCopyInto(out *CategoryTreeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryTreeStatus.
+func (in *CategoryTreeStatus) DeepCopy() *CategoryTreeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryTreeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedCategory) DeepCopyInto(out *ObservedCategory) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedCategory.
+func (in *ObservedCategory) DeepCopy() *ObservedCategory {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedCategory)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ConfigurableProductChildrenParameters are the configurable fields of a
+// ConfigurableProductChildren.
+type ConfigurableProductChildrenParameters struct {
+	// Sku of the configurable product.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sku is immutable"
+	Sku string `json:"sku"`
+	// ChildSkus is the complete set of child products. Children not listed
+	// are removed from the configurable product.
+	// +optional
+	ChildSkus []string `json:"childSkus,omitempty"`
+}
+
+// ConfigurableProductChildrenObservation are the observable fields of a
+// ConfigurableProductChildren.
+type ConfigurableProductChildrenObservation struct {
+	ChildSkus []string `json:"childSkus,omitempty"`
+}
+
+// A ConfigurableProductChildrenSpec defines the desired state of a ConfigurableProductChildren.
+type ConfigurableProductChildrenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigurableProductChildrenParameters `json:"forProvider"`
+}
+
+// A ConfigurableProductChildrenStatus represents the observed state of a ConfigurableProductChildren.
+type ConfigurableProductChildrenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigurableProductChildrenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigurableProductChildren is the complete set of child products of a
+// configurable product.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type ConfigurableProductChildren struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurableProductChildrenSpec   `json:"spec"`
+	Status ConfigurableProductChildrenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigurableProductChildrenList contains a list of ConfigurableProductChildren
+type ConfigurableProductChildrenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigurableProductChildren `json:"items"`
+}
+
+// ConfigurableProductChildren type metadata.
+var (
+	ConfigurableProductChildrenKind             = reflect.TypeOf(ConfigurableProductChildren{}).Name()
+	ConfigurableProductChildrenGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigurableProductChildrenKind}.String()
+	ConfigurableProductChildrenKindAPIVersion   = ConfigurableProductChildrenKind + "." + SchemeGroupVersion.String()
+	ConfigurableProductChildrenGroupVersionKind = SchemeGroupVersion.WithKind(ConfigurableProductChildrenKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ConfigurableProductChildren{}, &ConfigurableProductChildrenList{})
+}
@@ -0,0 +1,159 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurableProductChildren) DeepCopyInto(out *ConfigurableProductChildren) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurableProductChildren.
+func (in *ConfigurableProductChildren) DeepCopy() *ConfigurableProductChildren {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurableProductChildren)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigurableProductChildren) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurableProductChildrenList) DeepCopyInto(out *ConfigurableProductChildrenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConfigurableProductChildren, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurableProductChildrenList.
+func (in *ConfigurableProductChildrenList) DeepCopy() *ConfigurableProductChildrenList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurableProductChildrenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigurableProductChildrenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurableProductChildrenObservation) DeepCopyInto(out *ConfigurableProductChildrenObservation) {
+	*out = *in
+	if in.ChildSkus != nil {
+		in, out := &in.ChildSkus, &out.ChildSkus
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurableProductChildrenObservation.
+func (in *ConfigurableProductChildrenObservation) DeepCopy() *ConfigurableProductChildrenObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurableProductChildrenObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurableProductChildrenParameters) DeepCopyInto(out *ConfigurableProductChildrenParameters) {
+	*out = *in
+	if in.ChildSkus != nil {
+		in, out := &in.ChildSkus, &out.ChildSkus
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurableProductChildrenParameters.
+func (in *ConfigurableProductChildrenParameters) DeepCopy() *ConfigurableProductChildrenParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurableProductChildrenParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurableProductChildrenSpec) DeepCopyInto(out *ConfigurableProductChildrenSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurableProductChildrenSpec.
+func (in *ConfigurableProductChildrenSpec) DeepCopy() *ConfigurableProductChildrenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurableProductChildrenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurableProductChildrenStatus) DeepCopyInto(out *ConfigurableProductChildrenStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurableProductChildrenStatus.
+func (in *ConfigurableProductChildrenStatus) DeepCopy() *ConfigurableProductChildrenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurableProductChildrenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OptionValue is a value of a configurable product option.
+type OptionValue struct {
+	// ValueIndex is the ID of the attribute option, e.g. of the colour red.
+	ValueIndex int `json:"valueIndex"`
+}
+
+// ConfigurableProductOptionParameters are the configurable fields of a
+// ConfigurableProductOption.
+type ConfigurableProductOptionParameters struct {
+	// Sku of the configurable product.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sku is immutable"
+	Sku string `json:"sku"`
+	// AttributeID of the super attribute, like colour or size.
+	AttributeID  string        `json:"attributeId"`
+	Label        string        `json:"label,omitempty"`
+	Position     int           `json:"position,omitempty"`
+	IsUseDefault bool          `json:"isUseDefault,omitempty"`
+	Values       []OptionValue `json:"values"`
+}
+
+// ConfigurableProductOptionObservation are the observable fields of a
+// ConfigurableProductOption.
+type ConfigurableProductOptionObservation struct {
+	ID          int    `json:"id,omitempty"`
+	AttributeID string `json:"attributeId,omitempty"`
+	Label       string `json:"label,omitempty"`
+	Position    int    `json:"position,omitempty"`
+}
+
+// A ConfigurableProductOptionSpec defines the desired state of a ConfigurableProductOption.
+type ConfigurableProductOptionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigurableProductOptionParameters `json:"forProvider"`
+}
+
+// A ConfigurableProductOptionStatus represents the observed state of a ConfigurableProductOption.
+type ConfigurableProductOptionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigurableProductOptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigurableProductOption is a super attribute of a configurable
+// product, like the sizes it is offered in.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type ConfigurableProductOption struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurableProductOptionSpec   `json:"spec"`
+	Status ConfigurableProductOptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigurableProductOptionList contains a list of ConfigurableProductOption
+type ConfigurableProductOptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigurableProductOption `json:"items"`
+}
+
+// ConfigurableProductOption type metadata.
+var (
+	ConfigurableProductOptionKind             = reflect.TypeOf(ConfigurableProductOption{}).Name()
+	ConfigurableProductOptionGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigurableProductOptionKind}.String()
+	ConfigurableProductOptionKindAPIVersion   = ConfigurableProductOptionKind + "." + SchemeGroupVersion.String()
+	ConfigurableProductOptionGroupVersionKind = SchemeGroupVersion.WithKind(ConfigurableProductOptionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ConfigurableProductOption{}, &ConfigurableProductOptionList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CouponParameters are the configurable fields of a Coupon.
+type CouponParameters struct {
+	// RuleID is the ID of the SalesRule the coupon belongs to.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/salesrule/v1alpha1.SalesRule
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +optional
+	RuleID string `json:"ruleId,omitempty"`
+	// RuleIDRef references a SalesRule to retrieve its ID.
+	// +optional
+	RuleIDRef *xpv1.Reference `json:"ruleIdRef,omitempty"`
+	// RuleIDSelector selects a reference to a SalesRule to retrieve its ID.
+	// +optional
+	RuleIDSelector *xpv1.Selector `json:"ruleIdSelector,omitempty"`
+
+	Code string `json:"code"`
+	// UsageLimit of the coupon, unlimited if 0. It is always sent to
+	// Magento, so removing a limit takes effect.
+	// +optional
+	UsageLimit int `json:"usageLimit"`
+	// UsagePerCustomer limits the uses by each customer, unlimited if 0.
+	// +optional
+	UsagePerCustomer int `json:"usagePerCustomer"`
+	// ExpirationDate of the coupon, formatted as YYYY-MM-DD.
+	ExpirationDate string `json:"expirationDate,omitempty"`
+	// IsPrimary marks the coupon of a SalesRule with a specific coupon.
+	// +optional
+	IsPrimary bool `json:"isPrimary"`
+}
+
+// CouponObservation are the observable fields of a Coupon.
+type CouponObservation struct {
+	ID        int    `json:"id,omitempty"`
+	Code      string `json:"code,omitempty"`
+	TimesUsed int    `json:"timesUsed,omitempty"`
+}
+
+// A CouponSpec defines the desired state of a Coupon.
+type CouponSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CouponParameters `json:"forProvider"`
+}
+
+// A CouponStatus represents the observed state of a Coupon.
+type CouponStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CouponObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Coupon is a Magento coupon code of a cart price rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type Coupon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CouponSpec   `json:"spec"`
+	Status CouponStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CouponList contains a list of Coupon
+type CouponList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Coupon `json:"items"`
+}
+
+// Coupon type metadata.
+var (
+	CouponKind             = reflect.TypeOf(Coupon{}).Name()
+	CouponGroupKind        = schema.GroupKind{Group: Group, Kind: CouponKind}.String()
+	CouponKindAPIVersion   = CouponKind + "." + SchemeGroupVersion.String()
+	CouponGroupVersionKind = SchemeGroupVersion.WithKind(CouponKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Coupon{}, &CouponList{})
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomAttributes type
+type CustomAttributes struct {
+	AttributeCode string `json:"attribute_code"`
+	Value         string `json:"value"`
+}
+
+// CustomerAddressRegion is the region of a CustomerAddress.
+type CustomerAddressRegion struct {
+	RegionCode string `json:"regionCode,omitempty"`
+	Region     string `json:"region,omitempty"`
+	RegionID   int    `json:"regionId,omitempty"`
+}
+
+// CustomerAddress is an address book entry of a Customer.
+type CustomerAddress struct {
+	Firstname       string                 `json:"firstname,omitempty"`
+	Lastname        string                 `json:"lastname,omitempty"`
+	Company         string                 `json:"company,omitempty"`
+	Street          []string               `json:"street,omitempty"`
+	City            string                 `json:"city,omitempty"`
+	Region          *CustomerAddressRegion `json:"region,omitempty"`
+	RegionID        int                    `json:"regionId,omitempty"`
+	Postcode        string                 `json:"postcode,omitempty"`
+	CountryID       string                 `json:"countryId"`
+	Telephone       string                 `json:"telephone,omitempty"`
+	VatID           string                 `json:"vatId,omitempty"`
+	DefaultBilling  bool                   `json:"defaultBilling,omitempty"`
+	DefaultShipping bool                   `json:"defaultShipping,omitempty"`
+}
+
+// CustomerParameters are the configurable fields of a Customer.
+type CustomerParameters struct {
+	// Email of the customer, unique within the website.
+	Email string `json:"email"`
+	// WebsiteID is the website the customer account belongs to.
+	WebsiteID        int                `json:"websiteId"`
+	StoreID          int                `json:"storeId,omitempty"`
+	GroupID          int                `json:"groupId,omitempty"`
+	Prefix           string             `json:"prefix,omitempty"`
+	Firstname        string             `json:"firstname"`
+	Middlename       string             `json:"middlename,omitempty"`
+	Lastname         string             `json:"lastname"`
+	Suffix           string             `json:"suffix,omitempty"`
+	Dob              string             `json:"dob,omitempty"`
+	Gender           int                `json:"gender,omitempty"`
+	Taxvat           string             `json:"taxvat,omitempty"`
+	Addresses        []CustomerAddress  `json:"addresses,omitempty"`
+	CustomAttributes []CustomAttributes `json:"customAttributes,omitempty"`
+	// PasswordSecretRef references the Secret key holding the customer
+	// password. The password is set on creation and applied again whenever
+	// the Secret changes.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+}
+
+// CustomerObservation are the observable fields of a Customer.
+type CustomerObservation struct {
+	ID        int    `json:"id,omitempty"`
+	Email     string `json:"email,omitempty"`
+	GroupID   int    `json:"groupId,omitempty"`
+	WebsiteID int    `json:"websiteId,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	// PasswordSecretVersion is the resource version of the password Secret
+	// last applied to the customer.
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+}
+
+// A CustomerSpec defines the desired state of a Customer.
+type CustomerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomerParameters `json:"forProvider"`
+}
+
+// A CustomerStatus represents the observed state of a Customer.
+type CustomerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CustomerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Customer is a Magento customer account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".spec.forProvider.email"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type Customer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomerSpec   `json:"spec"`
+	Status CustomerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomerList contains a list of Customer
+type CustomerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Customer `json:"items"`
+}
+
+// Customer type metadata.
+var (
+	CustomerKind             = reflect.TypeOf(Customer{}).Name()
+	CustomerGroupKind        = schema.GroupKind{Group: Group, Kind: CustomerKind}.String()
+	CustomerKindAPIVersion   = CustomerKind + "." + SchemeGroupVersion.String()
+	CustomerGroupVersionKind = SchemeGroupVersion.WithKind(CustomerKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Customer{}, &CustomerList{})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomerGroupParameters are the configurable fields of a CustomerGroup.
+type CustomerGroupParameters struct {
+	Code string `json:"code"`
+	// TaxClassID is the ID of the customer tax class of the group.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/taxclass/v1alpha1.TaxClass
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +optional
+	TaxClassID string `json:"taxClassId,omitempty"`
+	// TaxClassIDRef references a TaxClass to retrieve its ID.
+	// +optional
+	TaxClassIDRef *xpv1.Reference `json:"taxClassIdRef,omitempty"`
+	// TaxClassIDSelector selects a reference to a TaxClass to retrieve its ID.
+	// +optional
+	TaxClassIDSelector *xpv1.Selector `json:"taxClassIdSelector,omitempty"`
+}
+
+// CustomerGroupObservation are the observable fields of a CustomerGroup.
+type CustomerGroupObservation struct {
+	ID           int    `json:"id,omitempty"`
+	Code         string `json:"code,omitempty"`
+	TaxClassID   int    `json:"taxClassId,omitempty"`
+	TaxClassName string `json:"taxClassName,omitempty"`
+}
+
+// A CustomerGroupSpec defines the desired state of a CustomerGroup.
+type CustomerGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomerGroupParameters `json:"forProvider"`
+}
+
+// A CustomerGroupStatus represents the observed state of a CustomerGroup.
+type CustomerGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CustomerGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CustomerGroup is a Magento customer group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type CustomerGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomerGroupSpec   `json:"spec"`
+	Status CustomerGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomerGroupList contains a list of CustomerGroup
+type CustomerGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomerGroup `json:"items"`
+}
+
+// CustomerGroup type metadata.
+var (
+	CustomerGroupKind             = reflect.TypeOf(CustomerGroup{}).Name()
+	CustomerGroupGroupKind        = schema.GroupKind{Group: Group, Kind: CustomerGroupKind}.String()
+	CustomerGroupKindAPIVersion   = CustomerGroupKind + "." + SchemeGroupVersion.String()
+	CustomerGroupGroupVersionKind = SchemeGroupVersion.WithKind(CustomerGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CustomerGroup{}, &CustomerGroupList{})
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// InventorySourceParameters are the configurable fields of an
+// InventorySource.
+type InventorySourceParameters struct {
+	// SourceCode identifies the source in Magento and can not be changed.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceCode is immutable"
+	SourceCode  string `json:"sourceCode"`
+	Name        string `json:"name"`
+	Enabled     bool   `json:"enabled"`
+	Description string `json:"description,omitempty"`
+	Email       string `json:"email,omitempty"`
+	ContactName string `json:"contactName,omitempty"`
+	// Latitude of the source, e.g. "47.0105".
+	Latitude string `json:"latitude,omitempty"`
+	// Longitude of the source, e.g. "28.8638".
+	Longitude               string `json:"longitude,omitempty"`
+	CountryID               string `json:"countryId"`
+	RegionID                int    `json:"regionId,omitempty"`
+	Region                  string `json:"region,omitempty"`
+	City                    string `json:"city,omitempty"`
+	Street                  string `json:"street,omitempty"`
+	Postcode                string `json:"postcode"`
+	Phone                   string `json:"phone,omitempty"`
+	Fax                     string `json:"fax,omitempty"`
+	UseDefaultCarrierConfig bool   `json:"useDefaultCarrierConfig,omitempty"`
+}
+
+// InventorySourceObservation are the observable fields of an
+// InventorySource.
+type InventorySourceObservation struct {
+	Name string `json:"name,omitempty"`
+}
+
+// A InventorySourceSpec defines the desired state of a InventorySource.
+type InventorySourceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InventorySourceParameters `json:"forProvider"`
+}
+
+// A InventorySourceStatus represents the observed state of a InventorySource.
+type InventorySourceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InventorySourceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A InventorySource is a Multi-Source Inventory source, like a warehouse.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type InventorySource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InventorySourceSpec   `json:"spec"`
+	Status InventorySourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InventorySourceList contains a list of InventorySource
+type InventorySourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InventorySource `json:"items"`
+}
+
+// InventorySource type metadata.
+var (
+	InventorySourceKind             = reflect.TypeOf(InventorySource{}).Name()
+	InventorySourceGroupKind        = schema.GroupKind{Group: Group, Kind: InventorySourceKind}.String()
+	InventorySourceKindAPIVersion   = InventorySourceKind + "." + SchemeGroupVersion.String()
+	InventorySourceGroupVersionKind = SchemeGroupVersion.WithKind(InventorySourceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InventorySource{}, &InventorySourceList{})
+}
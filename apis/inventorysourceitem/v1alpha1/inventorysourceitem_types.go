@@ -0,0 +1,112 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// InventorySourceItemParameters are the configurable fields of an
+// InventorySourceItem.
+type InventorySourceItemParameters struct {
+	// Sku of the product, which together with the source code identifies
+	// the source item.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sku is immutable"
+	Sku string `json:"sku"`
+	// SourceCode of the source holding the product.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/inventorysource/v1alpha1.InventorySource
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +optional
+	SourceCode string `json:"sourceCode,omitempty"`
+	// SourceCodeRef references an InventorySource to retrieve its code.
+	// +optional
+	SourceCodeRef *xpv1.Reference `json:"sourceCodeRef,omitempty"`
+	// SourceCodeSelector selects a reference to an InventorySource to
+	// retrieve its code.
+	// +optional
+	SourceCodeSelector *xpv1.Selector `json:"sourceCodeSelector,omitempty"`
+	// Quantity of the product at the source, e.g. "10" or "2.5".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Quantity string `json:"quantity"`
+	// Status of the product at the source, 1 for in stock and 0 for out of
+	// stock.
+	// +kubebuilder:validation:Enum=0;1
+	Status int `json:"status"`
+}
+
+// InventorySourceItemObservation are the observable fields of an
+// InventorySourceItem.
+type InventorySourceItemObservation struct {
+	Quantity string `json:"quantity,omitempty"`
+	Status   int    `json:"status,omitempty"`
+}
+
+// A InventorySourceItemSpec defines the desired state of a InventorySourceItem.
+type InventorySourceItemSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InventorySourceItemParameters `json:"forProvider"`
+}
+
+// A InventorySourceItemStatus represents the observed state of a InventorySourceItem.
+type InventorySourceItemStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InventorySourceItemObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A InventorySourceItem is the quantity and stock status of a product at a
+// Multi-Source Inventory source.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type InventorySourceItem struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InventorySourceItemSpec   `json:"spec"`
+	Status InventorySourceItemStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InventorySourceItemList contains a list of InventorySourceItem
+type InventorySourceItemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InventorySourceItem `json:"items"`
+}
+
+// InventorySourceItem type metadata.
+var (
+	InventorySourceItemKind             = reflect.TypeOf(InventorySourceItem{}).Name()
+	InventorySourceItemGroupKind        = schema.GroupKind{Group: Group, Kind: InventorySourceItemKind}.String()
+	InventorySourceItemKindAPIVersion   = InventorySourceItemKind + "." + SchemeGroupVersion.String()
+	InventorySourceItemGroupVersionKind = SchemeGroupVersion.WithKind(InventorySourceItemKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InventorySourceItem{}, &InventorySourceItemList{})
+}
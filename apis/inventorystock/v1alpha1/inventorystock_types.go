@@ -0,0 +1,135 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SalesChannel a stock is assigned to.
+type SalesChannel struct {
+	// +kubebuilder:validation:Enum=website
+	// +kubebuilder:default=website
+	Type string `json:"type"`
+	// Code of the sales channel, e.g. the website code.
+	Code string `json:"code"`
+}
+
+// StockExtensionAttributes are the extension attributes of an
+// InventoryStock.
+type StockExtensionAttributes struct {
+	SalesChannels []SalesChannel `json:"salesChannels,omitempty"`
+}
+
+// StockSourceLink links a source to a stock.
+type StockSourceLink struct {
+	// SourceCode of the linked source.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/inventorysource/v1alpha1.InventorySource
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +optional
+	SourceCode string `json:"sourceCode,omitempty"`
+	// SourceCodeRef references an InventorySource to retrieve its code.
+	// +optional
+	SourceCodeRef *xpv1.Reference `json:"sourceCodeRef,omitempty"`
+	// SourceCodeSelector selects a reference to an InventorySource to
+	// retrieve its code.
+	// +optional
+	SourceCodeSelector *xpv1.Selector `json:"sourceCodeSelector,omitempty"`
+	// Priority of the source within the stock, lower values first.
+	Priority int `json:"priority,omitempty"`
+}
+
+// InventoryStockParameters are the configurable fields of an
+// InventoryStock.
+type InventoryStockParameters struct {
+	Name                string                    `json:"name"`
+	ExtensionAttributes *StockExtensionAttributes `json:"extensionAttributes,omitempty"`
+	// SourceLinks are the sources of the stock. When set, links to sources
+	// not listed are removed. An empty list is treated like an omitted one,
+	// as Kubernetes does not tell them apart, and leaves the links of the
+	// stock as they are; unlink sources by listing the remaining ones.
+	// +optional
+	SourceLinks []StockSourceLink `json:"sourceLinks,omitempty"`
+}
+
+// ObservedStockSourceLink is a source linked to a stock in Magento.
+type ObservedStockSourceLink struct {
+	SourceCode string `json:"sourceCode"`
+	Priority   int    `json:"priority"`
+}
+
+// InventoryStockObservation are the observable fields of an InventoryStock.
+type InventoryStockObservation struct {
+	ID          int                       `json:"id,omitempty"`
+	Name        string                    `json:"name,omitempty"`
+	SourceLinks []ObservedStockSourceLink `json:"sourceLinks,omitempty"`
+}
+
+// A InventoryStockSpec defines the desired state of a InventoryStock.
+type InventoryStockSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InventoryStockParameters `json:"forProvider"`
+}
+
+// A InventoryStockStatus represents the observed state of a InventoryStock.
+type InventoryStockStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InventoryStockObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A InventoryStock is a Multi-Source Inventory stock, aggregating sources for sales channels.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type InventoryStock struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InventoryStockSpec   `json:"spec"`
+	Status InventoryStockStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InventoryStockList contains a list of InventoryStock
+type InventoryStockList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InventoryStock `json:"items"`
+}
+
+// InventoryStock type metadata.
+var (
+	InventoryStockKind             = reflect.TypeOf(InventoryStock{}).Name()
+	InventoryStockGroupKind        = schema.GroupKind{Group: Group, Kind: InventoryStockKind}.String()
+	InventoryStockKindAPIVersion   = InventoryStockKind + "." + SchemeGroupVersion.String()
+	InventoryStockGroupVersionKind = SchemeGroupVersion.WithKind(InventoryStockKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&InventoryStock{}, &InventoryStockList{})
+}
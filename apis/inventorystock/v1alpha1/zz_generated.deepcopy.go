@@ -0,0 +1,242 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryStock) DeepCopyInto(out *InventoryStock) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStock.
+func (in *InventoryStock) DeepCopy() *InventoryStock {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryStock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InventoryStock) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryStockList) DeepCopyInto(out *InventoryStockList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InventoryStock, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStockList.
+func (in *InventoryStockList) DeepCopy() *InventoryStockList {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryStockList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InventoryStockList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryStockObservation) DeepCopyInto(out *InventoryStockObservation) {
+	*out = *in
+	if in.SourceLinks != nil {
+		in, out := &in.SourceLinks, &out.SourceLinks
+		*out = make([]ObservedStockSourceLink, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStockObservation.
+func (in *InventoryStockObservation) DeepCopy() *InventoryStockObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryStockObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryStockParameters) DeepCopyInto(out *InventoryStockParameters) {
+	*out = *in
+	if in.ExtensionAttributes != nil {
+		in, out := &in.ExtensionAttributes, &out.ExtensionAttributes
+		*out = new(StockExtensionAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceLinks != nil {
+		in, out := &in.SourceLinks, &out.SourceLinks
+		*out = make([]StockSourceLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStockParameters.
+func (in *InventoryStockParameters) DeepCopy() *InventoryStockParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryStockParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryStockSpec) DeepCopyInto(out *InventoryStockSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStockSpec.
+func (in *InventoryStockSpec) DeepCopy() *InventoryStockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryStockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryStockStatus) DeepCopyInto(out *InventoryStockStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryStockStatus.
+func (in *InventoryStockStatus) DeepCopy() *InventoryStockStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryStockStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedStockSourceLink) DeepCopyInto(out *ObservedStockSourceLink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedStockSourceLink.
+func (in *ObservedStockSourceLink) DeepCopy() *ObservedStockSourceLink {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedStockSourceLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesChannel) DeepCopyInto(out *SalesChannel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesChannel.
+func (in *SalesChannel) DeepCopy() *SalesChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockExtensionAttributes) DeepCopyInto(out *StockExtensionAttributes) {
+	*out = *in
+	if in.SalesChannels != nil {
+		in, out := &in.SalesChannels, &out.SalesChannels
+		*out = make([]SalesChannel, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockExtensionAttributes.
+func (in *StockExtensionAttributes) DeepCopy() *StockExtensionAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(StockExtensionAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockSourceLink) DeepCopyInto(out *StockSourceLink) {
+	*out = *in
+	if in.SourceCodeRef != nil {
+		in, out := &in.SourceCodeRef, &out.SourceCodeRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceCodeSelector != nil {
+		in, out := &in.SourceCodeSelector, &out.SourceCodeSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockSourceLink.
+func (in *StockSourceLink) DeepCopy() *StockSourceLink {
+	if in == nil {
+		return nil
+	}
+	out := new(StockSourceLink)
+	in.DeepCopyInto(out)
+	return out
+}
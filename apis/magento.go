@@ -21,6 +21,25 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	categoryv1alpha1 "github.com/web-seven/provider-magento/apis/category/v1alpha1"
+	categoryproductlinkv1alpha1 "github.com/web-seven/provider-magento/apis/categoryproductlink/v1alpha1"
+	categorytreev1alpha1 "github.com/web-seven/provider-magento/apis/categorytree/v1alpha1"
+	configurableproductchildrenv1alpha1 "github.com/web-seven/provider-magento/apis/configurableproductchildren/v1alpha1"
+	configurableproductoptionv1alpha1 "github.com/web-seven/provider-magento/apis/configurableproductoption/v1alpha1"
+	couponv1alpha1 "github.com/web-seven/provider-magento/apis/coupon/v1alpha1"
+	customerv1alpha1 "github.com/web-seven/provider-magento/apis/customer/v1alpha1"
+	customergroupv1alpha1 "github.com/web-seven/provider-magento/apis/customergroup/v1alpha1"
+	inventorysourcev1alpha1 "github.com/web-seven/provider-magento/apis/inventorysource/v1alpha1"
+	inventorysourceitemv1alpha1 "github.com/web-seven/provider-magento/apis/inventorysourceitem/v1alpha1"
+	inventorystockv1alpha1 "github.com/web-seven/provider-magento/apis/inventorystock/v1alpha1"
+	productcustomoptionv1alpha1 "github.com/web-seven/provider-magento/apis/productcustomoption/v1alpha1"
+	productlinksv1alpha1 "github.com/web-seven/provider-magento/apis/productlinks/v1alpha1"
+	productmediav1alpha1 "github.com/web-seven/provider-magento/apis/productmedia/v1alpha1"
+	producttierpricesv1alpha1 "github.com/web-seven/provider-magento/apis/producttierprices/v1alpha1"
+	salesrulev1alpha1 "github.com/web-seven/provider-magento/apis/salesrule/v1alpha1"
+	stockitemv1alpha1 "github.com/web-seven/provider-magento/apis/stockitem/v1alpha1"
+	taxclassv1alpha1 "github.com/web-seven/provider-magento/apis/taxclass/v1alpha1"
+	taxratev1alpha1 "github.com/web-seven/provider-magento/apis/taxrate/v1alpha1"
+	taxrulev1alpha1 "github.com/web-seven/provider-magento/apis/taxrule/v1alpha1"
 	magentov1alpha1 "github.com/web-seven/provider-magento/apis/v1alpha1"
 )
 
@@ -29,6 +48,25 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		magentov1alpha1.SchemeBuilder.AddToScheme,
 		categoryv1alpha1.SchemeBuilder.AddToScheme,
+		customerv1alpha1.SchemeBuilder.AddToScheme,
+		customergroupv1alpha1.SchemeBuilder.AddToScheme,
+		taxclassv1alpha1.SchemeBuilder.AddToScheme,
+		taxratev1alpha1.SchemeBuilder.AddToScheme,
+		taxrulev1alpha1.SchemeBuilder.AddToScheme,
+		salesrulev1alpha1.SchemeBuilder.AddToScheme,
+		couponv1alpha1.SchemeBuilder.AddToScheme,
+		inventorysourcev1alpha1.SchemeBuilder.AddToScheme,
+		inventorystockv1alpha1.SchemeBuilder.AddToScheme,
+		inventorysourceitemv1alpha1.SchemeBuilder.AddToScheme,
+		stockitemv1alpha1.SchemeBuilder.AddToScheme,
+		producttierpricesv1alpha1.SchemeBuilder.AddToScheme,
+		productmediav1alpha1.SchemeBuilder.AddToScheme,
+		categoryproductlinkv1alpha1.SchemeBuilder.AddToScheme,
+		productlinksv1alpha1.SchemeBuilder.AddToScheme,
+		configurableproductoptionv1alpha1.SchemeBuilder.AddToScheme,
+		configurableproductchildrenv1alpha1.SchemeBuilder.AddToScheme,
+		productcustomoptionv1alpha1.SchemeBuilder.AddToScheme,
+		categorytreev1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
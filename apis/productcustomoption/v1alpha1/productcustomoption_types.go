@@ -0,0 +1,127 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomOptionValue is a value of a select type custom option.
+type CustomOptionValue struct {
+	Title     string `json:"title"`
+	SortOrder int    `json:"sortOrder,omitempty"`
+	// Price of the value, e.g. "4.99".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Price string `json:"price"`
+	// +kubebuilder:validation:Enum=fixed;percent
+	// +kubebuilder:default=fixed
+	PriceType string `json:"priceType"`
+	Sku       string `json:"sku,omitempty"`
+}
+
+// ProductCustomOptionParameters are the configurable fields of a
+// ProductCustomOption.
+type ProductCustomOptionParameters struct {
+	// ProductSku of the product the option belongs to.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="productSku is immutable"
+	ProductSku string `json:"productSku"`
+	// Title of the option, which identifies it within the product.
+	Title string `json:"title"`
+	// +kubebuilder:validation:Enum=field;area;file;drop_down;radio;checkbox;multiple;date;date_time;time
+	Type      string `json:"type"`
+	IsRequire bool   `json:"isRequire"`
+	SortOrder int    `json:"sortOrder,omitempty"`
+	// Price of options which are not of a select type, e.g. "4.99".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Price string `json:"price,omitempty"`
+	// +kubebuilder:validation:Enum=fixed;percent
+	// +optional
+	PriceType     string `json:"priceType,omitempty"`
+	Sku           string `json:"sku,omitempty"`
+	MaxCharacters int    `json:"maxCharacters,omitempty"`
+	// Values of select type options, like drop_down and checkbox.
+	// +optional
+	Values []CustomOptionValue `json:"values,omitempty"`
+}
+
+// ProductCustomOptionObservation are the observable fields of a
+// ProductCustomOption.
+type ProductCustomOptionObservation struct {
+	// ID of the option.
+	ID        int    `json:"id,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Type      string `json:"type,omitempty"`
+	IsRequire bool   `json:"isRequire,omitempty"`
+	SortOrder int    `json:"sortOrder,omitempty"`
+}
+
+// A ProductCustomOptionSpec defines the desired state of a ProductCustomOption.
+type ProductCustomOptionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProductCustomOptionParameters `json:"forProvider"`
+}
+
+// A ProductCustomOptionStatus represents the observed state of a ProductCustomOption.
+type ProductCustomOptionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProductCustomOptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProductCustomOption is a customizable option of a product, like an
+// engraving text or gift wrapping.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type ProductCustomOption struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProductCustomOptionSpec   `json:"spec"`
+	Status ProductCustomOptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProductCustomOptionList contains a list of ProductCustomOption
+type ProductCustomOptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProductCustomOption `json:"items"`
+}
+
+// ProductCustomOption type metadata.
+var (
+	ProductCustomOptionKind             = reflect.TypeOf(ProductCustomOption{}).Name()
+	ProductCustomOptionGroupKind        = schema.GroupKind{Group: Group, Kind: ProductCustomOptionKind}.String()
+	ProductCustomOptionKindAPIVersion   = ProductCustomOptionKind + "." + SchemeGroupVersion.String()
+	ProductCustomOptionGroupVersionKind = SchemeGroupVersion.WithKind(ProductCustomOptionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProductCustomOption{}, &ProductCustomOptionList{})
+}
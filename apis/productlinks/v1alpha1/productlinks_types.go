@@ -0,0 +1,97 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ProductLinksParameters are the configurable fields of a ProductLinks.
+type ProductLinksParameters struct {
+	// Sku of the product the links belong to.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sku is immutable"
+	Sku string `json:"sku"`
+	// LinkType of the links, e.g. related, upsell or crosssell. It is
+	// validated against the link types reported by Magento.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="linkType is immutable"
+	LinkType string `json:"linkType"`
+	// LinkedSkus is the ordered list of linked products. Links to products
+	// not listed are removed.
+	// +optional
+	LinkedSkus []string `json:"linkedSkus,omitempty"`
+}
+
+// ProductLinksObservation are the observable fields of a ProductLinks.
+type ProductLinksObservation struct {
+	LinkedSkus []string `json:"linkedSkus,omitempty"`
+}
+
+// A ProductLinksSpec defines the desired state of a ProductLinks.
+type ProductLinksSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProductLinksParameters `json:"forProvider"`
+}
+
+// A ProductLinksStatus represents the observed state of a ProductLinks.
+type ProductLinksStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProductLinksObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProductLinks is the ordered list of products linked to a product by one
+// link type, like its related products.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type ProductLinks struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProductLinksSpec   `json:"spec"`
+	Status ProductLinksStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProductLinksList contains a list of ProductLinks
+type ProductLinksList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProductLinks `json:"items"`
+}
+
+// ProductLinks type metadata.
+var (
+	ProductLinksKind             = reflect.TypeOf(ProductLinks{}).Name()
+	ProductLinksGroupKind        = schema.GroupKind{Group: Group, Kind: ProductLinksKind}.String()
+	ProductLinksKindAPIVersion   = ProductLinksKind + "." + SchemeGroupVersion.String()
+	ProductLinksGroupVersionKind = SchemeGroupVersion.WithKind(ProductLinksKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProductLinks{}, &ProductLinksList{})
+}
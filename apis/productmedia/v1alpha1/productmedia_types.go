@@ -0,0 +1,146 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+	// Key within the ConfigMap, read from binaryData or else from data.
+	Key string `json:"key"`
+}
+
+// MediaContentSource is the source of the binary content of a media
+// gallery entry. Exactly one source has to be set.
+// +kubebuilder:validation:XValidation:rule="[has(self.configMapKeyRef), has(self.secretKeyRef), has(self.url)].filter(x, x).size() == 1",message="exactly one of configMapKeyRef, secretKeyRef and url must be set"
+type MediaContentSource struct {
+	// ConfigMapKeyRef references a ConfigMap key holding the content.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef references a Secret key holding the content.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// URL the content is fetched from over HTTP.
+	// +optional
+	URL string `json:"url,omitempty"`
+}
+
+// ProductMediaParameters are the configurable fields of a ProductMedia.
+type ProductMediaParameters struct {
+	// Sku of the product the media belongs to.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sku is immutable"
+	Sku string `json:"sku"`
+	// +kubebuilder:validation:Enum=image
+	// +kubebuilder:default=image
+	// +optional
+	MediaType string `json:"mediaType,omitempty"`
+	Label     string `json:"label,omitempty"`
+	Position  int    `json:"position,omitempty"`
+	Disabled  bool   `json:"disabled"`
+	// Types are the roles of the image.
+	// +optional
+	Types []MediaRole `json:"types,omitempty"`
+	// ContentFrom is the source of the image, which is uploaded again
+	// whenever it changes.
+	ContentFrom MediaContentSource `json:"contentFrom"`
+	// FileName of the uploaded image. Defaults to the name of the key or
+	// of the file in the URL.
+	// +optional
+	FileName string `json:"fileName,omitempty"`
+}
+
+// MediaRole is a role of an image, like the thumbnail of the product.
+// +kubebuilder:validation:Enum=image;small_image;thumbnail;swatch_image
+type MediaRole string
+
+// ProductMediaObservation are the observable fields of a ProductMedia.
+type ProductMediaObservation struct {
+	ID        int    `json:"id,omitempty"`
+	MediaType string `json:"mediaType,omitempty"`
+	Label     string `json:"label,omitempty"`
+	Position  int    `json:"position,omitempty"`
+	Disabled  bool   `json:"disabled,omitempty"`
+	// ContentHash is the SHA-256 hash of the uploaded content.
+	ContentHash string `json:"contentHash,omitempty"`
+	// ContentURL is the URL the uploaded content was fetched from.
+	ContentURL string `json:"contentURL,omitempty"`
+	// ContentETag is the entity tag of the content fetched from ContentURL,
+	// so it is only downloaded again when it changed.
+	ContentETag string `json:"contentETag,omitempty"`
+}
+
+// A ProductMediaSpec defines the desired state of a ProductMedia.
+type ProductMediaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProductMediaParameters `json:"forProvider"`
+}
+
+// A ProductMediaStatus represents the observed state of a ProductMedia.
+type ProductMediaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProductMediaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProductMedia is an image in the media gallery of a product.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type ProductMedia struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProductMediaSpec   `json:"spec"`
+	Status ProductMediaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProductMediaList contains a list of ProductMedia
+type ProductMediaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProductMedia `json:"items"`
+}
+
+// ProductMedia type metadata.
+var (
+	ProductMediaKind             = reflect.TypeOf(ProductMedia{}).Name()
+	ProductMediaGroupKind        = schema.GroupKind{Group: Group, Kind: ProductMediaKind}.String()
+	ProductMediaKindAPIVersion   = ProductMediaKind + "." + SchemeGroupVersion.String()
+	ProductMediaGroupVersionKind = SchemeGroupVersion.WithKind(ProductMediaKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProductMedia{}, &ProductMediaList{})
+}
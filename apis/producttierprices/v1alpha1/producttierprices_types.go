@@ -0,0 +1,118 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TierPrice is a price of a product applying from a quantity on.
+type TierPrice struct {
+	// Quantity from which the price applies, e.g. "10".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Quantity string `json:"quantity"`
+	// CustomerGroup is the code of the customer group the price applies
+	// to, or "ALL GROUPS".
+	// +kubebuilder:default="ALL GROUPS"
+	// +optional
+	CustomerGroup string `json:"customerGroup,omitempty"`
+	// WebsiteID is the website the price applies to, 0 for all websites.
+	WebsiteID int `json:"websiteId"`
+	// Price is the fixed price, or the percentage of discount for the
+	// discount price type, e.g. "9.99".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Price string `json:"price"`
+	// PriceType is fixed for a fixed price or discount for a percentage of
+	// discount.
+	// +kubebuilder:validation:Enum=fixed;discount
+	// +kubebuilder:default=fixed
+	// +optional
+	PriceType string `json:"priceType,omitempty"`
+}
+
+// ProductTierPricesParameters are the configurable fields of a
+// ProductTierPrices.
+type ProductTierPricesParameters struct {
+	// Sku of the product whose tier prices are declared.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sku is immutable"
+	Sku string `json:"sku"`
+	// Prices is the complete set of tier prices of the product. Tier prices
+	// not listed are removed.
+	// +optional
+	Prices []TierPrice `json:"prices,omitempty"`
+}
+
+// ProductTierPricesObservation are the observable fields of a
+// ProductTierPrices.
+type ProductTierPricesObservation struct {
+	Prices []TierPrice `json:"prices,omitempty"`
+}
+
+// A ProductTierPricesSpec defines the desired state of a ProductTierPrices.
+type ProductTierPricesSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ProductTierPricesParameters `json:"forProvider"`
+}
+
+// A ProductTierPricesStatus represents the observed state of a ProductTierPrices.
+type ProductTierPricesStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ProductTierPricesObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ProductTierPrices is the complete set of tier prices of a product.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type ProductTierPrices struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProductTierPricesSpec   `json:"spec"`
+	Status ProductTierPricesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProductTierPricesList contains a list of ProductTierPrices
+type ProductTierPricesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProductTierPrices `json:"items"`
+}
+
+// ProductTierPrices type metadata.
+var (
+	ProductTierPricesKind             = reflect.TypeOf(ProductTierPrices{}).Name()
+	ProductTierPricesGroupKind        = schema.GroupKind{Group: Group, Kind: ProductTierPricesKind}.String()
+	ProductTierPricesKindAPIVersion   = ProductTierPricesKind + "." + SchemeGroupVersion.String()
+	ProductTierPricesGroupVersionKind = SchemeGroupVersion.WithKind(ProductTierPricesKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ProductTierPrices{}, &ProductTierPricesList{})
+}
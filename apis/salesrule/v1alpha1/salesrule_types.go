@@ -0,0 +1,195 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SalesRuleLeafCondition is a condition nested in a SalesRuleSubCondition.
+type SalesRuleLeafCondition struct {
+	ConditionType string `json:"conditionType"`
+	Operator      string `json:"operator,omitempty"`
+	AttributeName string `json:"attributeName,omitempty"`
+	Value         string `json:"value,omitempty"`
+}
+
+// SalesRuleSubCondition is a condition nested in a SalesRuleCondition, like
+// a product attribute or a product subselection combining further conditions.
+type SalesRuleSubCondition struct {
+	ConditionType  string                   `json:"conditionType"`
+	AggregatorType string                   `json:"aggregatorType,omitempty"`
+	Operator       string                   `json:"operator,omitempty"`
+	AttributeName  string                   `json:"attributeName,omitempty"`
+	Value          string                   `json:"value,omitempty"`
+	Conditions     []SalesRuleLeafCondition `json:"conditions,omitempty"`
+}
+
+// SalesRuleCondition is the root condition of a SalesRule, combining its
+// nested conditions with the aggregator.
+type SalesRuleCondition struct {
+	ConditionType string `json:"conditionType"`
+	// +kubebuilder:validation:Enum=all;any
+	AggregatorType string                  `json:"aggregatorType,omitempty"`
+	Operator       string                  `json:"operator,omitempty"`
+	Value          string                  `json:"value,omitempty"`
+	Conditions     []SalesRuleSubCondition `json:"conditions,omitempty"`
+}
+
+// SalesRuleLabel is the label of a SalesRule in a store view.
+type SalesRuleLabel struct {
+	StoreID    int    `json:"store_id"`
+	StoreLabel string `json:"store_label"`
+}
+
+// CouponGeneration configures coupon codes generated for a SalesRule with
+// the AUTO coupon type.
+type CouponGeneration struct {
+	// Quantity of coupon codes to generate.
+	// +kubebuilder:validation:Minimum=1
+	Quantity int `json:"quantity"`
+	// Length of the generated coupon codes.
+	// +kubebuilder:validation:Minimum=1
+	Length int `json:"length"`
+	// +kubebuilder:validation:Enum=alphanum;alpha;num
+	// +kubebuilder:default=alphanum
+	Format           string `json:"format,omitempty"`
+	Prefix           string `json:"prefix,omitempty"`
+	Suffix           string `json:"suffix,omitempty"`
+	Delimiter        string `json:"delimiter,omitempty"`
+	DelimiterAtEvery int    `json:"delimiterAtEvery,omitempty"`
+}
+
+// SalesRuleParameters are the configurable fields of a SalesRule.
+type SalesRuleParameters struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IsActive    bool   `json:"isActive,omitempty"`
+	// WebsiteIDs are the IDs of the websites the rule applies to.
+	WebsiteIDs []int `json:"websiteIds"`
+
+	// CustomerGroupIDs are the IDs of the customer groups the rule
+	// applies to.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/customergroup/v1alpha1.CustomerGroup
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +crossplane:generate:reference:refFieldName=CustomerGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=CustomerGroupIDSelector
+	// +optional
+	CustomerGroupIDs []string `json:"customerGroupIds,omitempty"`
+	// CustomerGroupIDRefs references CustomerGroups to retrieve their IDs.
+	// +optional
+	CustomerGroupIDRefs []xpv1.Reference `json:"customerGroupIdRefs,omitempty"`
+	// CustomerGroupIDSelector selects references to CustomerGroups to
+	// retrieve their IDs.
+	// +optional
+	CustomerGroupIDSelector *xpv1.Selector `json:"customerGroupIdSelector,omitempty"`
+
+	// FromDate the rule is active from, formatted as YYYY-MM-DD.
+	FromDate string `json:"fromDate,omitempty"`
+	// ToDate the rule is active to, formatted as YYYY-MM-DD.
+	ToDate              string              `json:"toDate,omitempty"`
+	UsesPerCustomer     int                 `json:"usesPerCustomer,omitempty"`
+	Condition           *SalesRuleCondition `json:"condition,omitempty"`
+	ActionCondition     *SalesRuleCondition `json:"actionCondition,omitempty"`
+	StopRulesProcessing bool                `json:"stopRulesProcessing,omitempty"`
+	SortOrder           int                 `json:"sortOrder,omitempty"`
+	// +kubebuilder:validation:Enum=by_percent;by_fixed;cart_fixed;buy_x_get_y
+	SimpleAction string `json:"simpleAction,omitempty"`
+	// DiscountAmount is the amount or percent of the discount, e.g. "10".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	DiscountAmount string `json:"discountAmount,omitempty"`
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	DiscountQty     string `json:"discountQty,omitempty"`
+	DiscountStep    int    `json:"discountStep,omitempty"`
+	ApplyToShipping bool   `json:"applyToShipping,omitempty"`
+	IsRss           bool   `json:"isRss,omitempty"`
+	// +kubebuilder:validation:Enum=NO_COUPON;SPECIFIC_COUPON;AUTO
+	// +kubebuilder:default=NO_COUPON
+	CouponType        string           `json:"couponType,omitempty"`
+	UseAutoGeneration bool             `json:"useAutoGeneration,omitempty"`
+	UsesPerCoupon     int              `json:"usesPerCoupon,omitempty"`
+	StoreLabels       []SalesRuleLabel `json:"storeLabels,omitempty"`
+
+	// CouponGeneration generates coupon codes for the rule, which are
+	// published as connection details. Requires the AUTO coupon type.
+	// +optional
+	CouponGeneration *CouponGeneration `json:"couponGeneration,omitempty"`
+}
+
+// SalesRuleObservation are the observable fields of a SalesRule.
+type SalesRuleObservation struct {
+	ID        int    `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	TimesUsed int    `json:"timesUsed,omitempty"`
+	// GeneratedCoupons is the number of coupon codes generated for the rule.
+	GeneratedCoupons int `json:"generatedCoupons,omitempty"`
+}
+
+// A SalesRuleSpec defines the desired state of a SalesRule.
+type SalesRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SalesRuleParameters `json:"forProvider"`
+}
+
+// A SalesRuleStatus represents the observed state of a SalesRule.
+type SalesRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SalesRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SalesRule is a Magento cart price rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type SalesRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SalesRuleSpec   `json:"spec"`
+	Status SalesRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SalesRuleList contains a list of SalesRule
+type SalesRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SalesRule `json:"items"`
+}
+
+// SalesRule type metadata.
+var (
+	SalesRuleKind             = reflect.TypeOf(SalesRule{}).Name()
+	SalesRuleGroupKind        = schema.GroupKind{Group: Group, Kind: SalesRuleKind}.String()
+	SalesRuleKindAPIVersion   = SalesRuleKind + "." + SchemeGroupVersion.String()
+	SalesRuleGroupVersionKind = SchemeGroupVersion.WithKind(SalesRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SalesRule{}, &SalesRuleList{})
+}
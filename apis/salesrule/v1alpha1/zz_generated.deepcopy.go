@@ -0,0 +1,279 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CouponGeneration) DeepCopyInto(out *CouponGeneration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CouponGeneration.
+func (in *CouponGeneration) DeepCopy() *CouponGeneration {
+	if in == nil {
+		return nil
+	}
+	out := new(CouponGeneration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRule) DeepCopyInto(out *SalesRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRule.
+func (in *SalesRule) DeepCopy() *SalesRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SalesRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleCondition) DeepCopyInto(out *SalesRuleCondition) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]SalesRuleSubCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleCondition.
+func (in *SalesRuleCondition) DeepCopy() *SalesRuleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleLabel) DeepCopyInto(out *SalesRuleLabel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleLabel.
+func (in *SalesRuleLabel) DeepCopy() *SalesRuleLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleLeafCondition) DeepCopyInto(out *SalesRuleLeafCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleLeafCondition.
+func (in *SalesRuleLeafCondition) DeepCopy() *SalesRuleLeafCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleLeafCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleList) DeepCopyInto(out *SalesRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SalesRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleList.
+func (in *SalesRuleList) DeepCopy() *SalesRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SalesRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleObservation) DeepCopyInto(out *SalesRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleObservation.
+func (in *SalesRuleObservation) DeepCopy() *SalesRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleParameters) DeepCopyInto(out *SalesRuleParameters) {
+	*out = *in
+	if in.WebsiteIDs != nil {
+		in, out := &in.WebsiteIDs, &out.WebsiteIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomerGroupIDs != nil {
+		in, out := &in.CustomerGroupIDs, &out.CustomerGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomerGroupIDRefs != nil {
+		in, out := &in.CustomerGroupIDRefs, &out.CustomerGroupIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomerGroupIDSelector != nil {
+		in, out := &in.CustomerGroupIDSelector, &out.CustomerGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(SalesRuleCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActionCondition != nil {
+		in, out := &in.ActionCondition, &out.ActionCondition
+		*out = new(SalesRuleCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StoreLabels != nil {
+		in, out := &in.StoreLabels, &out.StoreLabels
+		*out = make([]SalesRuleLabel, len(*in))
+		copy(*out, *in)
+	}
+	if in.CouponGeneration != nil {
+		in, out := &in.CouponGeneration, &out.CouponGeneration
+		*out = new(CouponGeneration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleParameters.
+func (in *SalesRuleParameters) DeepCopy() *SalesRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleSpec) DeepCopyInto(out *SalesRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleSpec.
+func (in *SalesRuleSpec) DeepCopy() *SalesRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleStatus) DeepCopyInto(out *SalesRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleStatus.
+func (in *SalesRuleStatus) DeepCopy() *SalesRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesRuleSubCondition) DeepCopyInto(out *SalesRuleSubCondition) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]SalesRuleLeafCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesRuleSubCondition.
+func (in *SalesRuleSubCondition) DeepCopy() *SalesRuleSubCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesRuleSubCondition)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// StockItemParameters are the configurable fields of a StockItem.
+type StockItemParameters struct {
+	// Sku of the product whose stock item is managed.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sku is immutable"
+	Sku string `json:"sku"`
+	// Qty of the product in stock, e.g. "10" or "2.5".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Qty       string `json:"qty"`
+	IsInStock bool   `json:"isInStock"`
+	// MinSaleQty is the minimum quantity allowed in the shopping cart,
+	// applied unless useConfigMinSaleQty is true.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MinSaleQty          string `json:"minSaleQty,omitempty"`
+	UseConfigMinSaleQty *bool  `json:"useConfigMinSaleQty,omitempty"`
+	// MaxSaleQty is the maximum quantity allowed in the shopping cart,
+	// applied unless useConfigMaxSaleQty is true.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	MaxSaleQty          string `json:"maxSaleQty,omitempty"`
+	UseConfigMaxSaleQty *bool  `json:"useConfigMaxSaleQty,omitempty"`
+	// Backorders are 0 for no backorders, 1 to allow qty below 0 and 2 to
+	// allow qty below 0 and notify the customer, applied unless
+	// useConfigBackorders is true.
+	// +kubebuilder:validation:Enum=0;1;2
+	// +optional
+	Backorders          *int  `json:"backorders,omitempty"`
+	UseConfigBackorders *bool `json:"useConfigBackorders,omitempty"`
+}
+
+// StockItemObservation are the observable fields of a StockItem.
+type StockItemObservation struct {
+	// ID of the stock item, discovered from the product.
+	ID         int    `json:"id,omitempty"`
+	Qty        string `json:"qty,omitempty"`
+	IsInStock  bool   `json:"isInStock,omitempty"`
+	MinSaleQty string `json:"minSaleQty,omitempty"`
+	MaxSaleQty string `json:"maxSaleQty,omitempty"`
+	Backorders int    `json:"backorders,omitempty"`
+}
+
+// A StockItemSpec defines the desired state of a StockItem.
+type StockItemSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StockItemParameters `json:"forProvider"`
+}
+
+// A StockItemStatus represents the observed state of a StockItem.
+type StockItemStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StockItemObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StockItem is the stock of a product in a single-source store, managed
+// through the legacy catalog inventory API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type StockItem struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StockItemSpec   `json:"spec"`
+	Status StockItemStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StockItemList contains a list of StockItem
+type StockItemList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StockItem `json:"items"`
+}
+
+// StockItem type metadata.
+var (
+	StockItemKind             = reflect.TypeOf(StockItem{}).Name()
+	StockItemGroupKind        = schema.GroupKind{Group: Group, Kind: StockItemKind}.String()
+	StockItemKindAPIVersion   = StockItemKind + "." + SchemeGroupVersion.String()
+	StockItemGroupVersionKind = SchemeGroupVersion.WithKind(StockItemKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StockItem{}, &StockItemList{})
+}
@@ -0,0 +1,169 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockItem) DeepCopyInto(out *StockItem) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockItem.
+func (in *StockItem) DeepCopy() *StockItem {
+	if in == nil {
+		return nil
+	}
+	out := new(StockItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StockItem) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockItemList) DeepCopyInto(out *StockItemList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StockItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockItemList.
+func (in *StockItemList) DeepCopy() *StockItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(StockItemList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StockItemList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockItemObservation) DeepCopyInto(out *StockItemObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockItemObservation.
+func (in *StockItemObservation) DeepCopy() *StockItemObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(StockItemObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockItemParameters) DeepCopyInto(out *StockItemParameters) {
+	*out = *in
+	if in.UseConfigMinSaleQty != nil {
+		in, out := &in.UseConfigMinSaleQty, &out.UseConfigMinSaleQty
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UseConfigMaxSaleQty != nil {
+		in, out := &in.UseConfigMaxSaleQty, &out.UseConfigMaxSaleQty
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Backorders != nil {
+		in, out := &in.Backorders, &out.Backorders
+		*out = new(int)
+		**out = **in
+	}
+	if in.UseConfigBackorders != nil {
+		in, out := &in.UseConfigBackorders, &out.UseConfigBackorders
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockItemParameters.
+func (in *StockItemParameters) DeepCopy() *StockItemParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(StockItemParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockItemSpec) DeepCopyInto(out *StockItemSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockItemSpec.
+func (in *StockItemSpec) DeepCopy() *StockItemSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StockItemSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StockItemStatus) DeepCopyInto(out *StockItemStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StockItemStatus.
+func (in *StockItemStatus) DeepCopy() *StockItemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StockItemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TaxClassParameters are the configurable fields of a TaxClass.
+type TaxClassParameters struct {
+	ClassName string `json:"className"`
+	// ClassType tells whether the class applies to customers or products.
+	// +kubebuilder:validation:Enum=CUSTOMER;PRODUCT
+	ClassType string `json:"classType"`
+}
+
+// TaxClassObservation are the observable fields of a TaxClass.
+type TaxClassObservation struct {
+	ID        int    `json:"id,omitempty"`
+	ClassName string `json:"className,omitempty"`
+	ClassType string `json:"classType,omitempty"`
+}
+
+// A TaxClassSpec defines the desired state of a TaxClass.
+type TaxClassSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaxClassParameters `json:"forProvider"`
+}
+
+// A TaxClassStatus represents the observed state of a TaxClass.
+type TaxClassStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaxClassObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TaxClass is a Magento tax class of customers or products.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type TaxClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaxClassSpec   `json:"spec"`
+	Status TaxClassStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaxClassList contains a list of TaxClass
+type TaxClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TaxClass `json:"items"`
+}
+
+// TaxClass type metadata.
+var (
+	TaxClassKind             = reflect.TypeOf(TaxClass{}).Name()
+	TaxClassGroupKind        = schema.GroupKind{Group: Group, Kind: TaxClassKind}.String()
+	TaxClassKindAPIVersion   = TaxClassKind + "." + SchemeGroupVersion.String()
+	TaxClassGroupVersionKind = SchemeGroupVersion.WithKind(TaxClassKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TaxClass{}, &TaxClassList{})
+}
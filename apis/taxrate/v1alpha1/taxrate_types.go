@@ -0,0 +1,108 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TaxRateTitle is the title of a TaxRate in a store view.
+type TaxRateTitle struct {
+	StoreID int    `json:"store_id"`
+	Value   string `json:"value"`
+}
+
+// TaxRateParameters are the configurable fields of a TaxRate.
+type TaxRateParameters struct {
+	Code         string `json:"code"`
+	TaxCountryID string `json:"taxCountryId"`
+	TaxRegionID  int    `json:"taxRegionId,omitempty"`
+	RegionName   string `json:"regionName,omitempty"`
+	// TaxPostcode is the postcode the rate applies to, '*' for any.
+	TaxPostcode string `json:"taxPostcode,omitempty"`
+	// ZipIsRange makes the rate apply to the postcodes from ZipFrom to ZipTo.
+	ZipIsRange bool `json:"zipIsRange,omitempty"`
+	ZipFrom    int  `json:"zipFrom,omitempty"`
+	ZipTo      int  `json:"zipTo,omitempty"`
+	// Rate in percent, e.g. "20.00".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	Rate   string         `json:"rate"`
+	Titles []TaxRateTitle `json:"titles,omitempty"`
+}
+
+// TaxRateObservation are the observable fields of a TaxRate.
+type TaxRateObservation struct {
+	ID           int    `json:"id,omitempty"`
+	Code         string `json:"code,omitempty"`
+	TaxCountryID string `json:"taxCountryId,omitempty"`
+	TaxPostcode  string `json:"taxPostcode,omitempty"`
+}
+
+// A TaxRateSpec defines the desired state of a TaxRate.
+type TaxRateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaxRateParameters `json:"forProvider"`
+}
+
+// A TaxRateStatus represents the observed state of a TaxRate.
+type TaxRateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaxRateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TaxRate is a Magento tax rate of a country, region or postcode range.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type TaxRate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaxRateSpec   `json:"spec"`
+	Status TaxRateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaxRateList contains a list of TaxRate
+type TaxRateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TaxRate `json:"items"`
+}
+
+// TaxRate type metadata.
+var (
+	TaxRateKind             = reflect.TypeOf(TaxRate{}).Name()
+	TaxRateGroupKind        = schema.GroupKind{Group: Group, Kind: TaxRateKind}.String()
+	TaxRateKindAPIVersion   = TaxRateKind + "." + SchemeGroupVersion.String()
+	TaxRateGroupVersionKind = SchemeGroupVersion.WithKind(TaxRateKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TaxRate{}, &TaxRateList{})
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TaxRuleParameters are the configurable fields of a TaxRule.
+type TaxRuleParameters struct {
+	Code              string `json:"code"`
+	Priority          int    `json:"priority,omitempty"`
+	Position          int    `json:"position,omitempty"`
+	CalculateSubtotal bool   `json:"calculateSubtotal,omitempty"`
+
+	// CustomerTaxClassIDs are the IDs of the customer tax classes the rule
+	// applies to.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/taxclass/v1alpha1.TaxClass
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +crossplane:generate:reference:refFieldName=CustomerTaxClassIDRefs
+	// +crossplane:generate:reference:selectorFieldName=CustomerTaxClassIDSelector
+	// +optional
+	CustomerTaxClassIDs []string `json:"customerTaxClassIds,omitempty"`
+	// CustomerTaxClassIDRefs references TaxClasses to retrieve their IDs.
+	// +optional
+	CustomerTaxClassIDRefs []xpv1.Reference `json:"customerTaxClassIdRefs,omitempty"`
+	// CustomerTaxClassIDSelector selects references to TaxClasses to
+	// retrieve their IDs.
+	// +optional
+	CustomerTaxClassIDSelector *xpv1.Selector `json:"customerTaxClassIdSelector,omitempty"`
+
+	// ProductTaxClassIDs are the IDs of the product tax classes the rule
+	// applies to.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/taxclass/v1alpha1.TaxClass
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +crossplane:generate:reference:refFieldName=ProductTaxClassIDRefs
+	// +crossplane:generate:reference:selectorFieldName=ProductTaxClassIDSelector
+	// +optional
+	ProductTaxClassIDs []string `json:"productTaxClassIds,omitempty"`
+	// ProductTaxClassIDRefs references TaxClasses to retrieve their IDs.
+	// +optional
+	ProductTaxClassIDRefs []xpv1.Reference `json:"productTaxClassIdRefs,omitempty"`
+	// ProductTaxClassIDSelector selects references to TaxClasses to retrieve
+	// their IDs.
+	// +optional
+	ProductTaxClassIDSelector *xpv1.Selector `json:"productTaxClassIdSelector,omitempty"`
+
+	// TaxRateIDs are the IDs of the tax rates applied by the rule.
+	// +crossplane:generate:reference:type=github.com/web-seven/provider-magento/apis/taxrate/v1alpha1.TaxRate
+	// +crossplane:generate:reference:extractor=github.com/web-seven/provider-magento/apis/v1alpha1.ExternalID()
+	// +crossplane:generate:reference:refFieldName=TaxRateIDRefs
+	// +crossplane:generate:reference:selectorFieldName=TaxRateIDSelector
+	// +optional
+	TaxRateIDs []string `json:"taxRateIds,omitempty"`
+	// TaxRateIDRefs references TaxRates to retrieve their IDs.
+	// +optional
+	TaxRateIDRefs []xpv1.Reference `json:"taxRateIdRefs,omitempty"`
+	// TaxRateIDSelector selects references to TaxRates to retrieve their IDs.
+	// +optional
+	TaxRateIDSelector *xpv1.Selector `json:"taxRateIdSelector,omitempty"`
+}
+
+// TaxRuleObservation are the observable fields of a TaxRule.
+type TaxRuleObservation struct {
+	ID                  int   `json:"id,omitempty"`
+	CustomerTaxClassIDs []int `json:"customerTaxClassIds,omitempty"`
+	ProductTaxClassIDs  []int `json:"productTaxClassIds,omitempty"`
+	TaxRateIDs          []int `json:"taxRateIds,omitempty"`
+}
+
+// A TaxRuleSpec defines the desired state of a TaxRule.
+type TaxRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TaxRuleParameters `json:"forProvider"`
+}
+
+// A TaxRuleStatus represents the observed state of a TaxRule.
+type TaxRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TaxRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TaxRule is a Magento tax rule combining tax rates with customer and product tax classes.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,magento}
+type TaxRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TaxRuleSpec   `json:"spec"`
+	Status TaxRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TaxRuleList contains a list of TaxRule
+type TaxRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TaxRule `json:"items"`
+}
+
+// TaxRule type metadata.
+var (
+	TaxRuleKind             = reflect.TypeOf(TaxRule{}).Name()
+	TaxRuleGroupKind        = schema.GroupKind{Group: Group, Kind: TaxRuleKind}.String()
+	TaxRuleKindAPIVersion   = TaxRuleKind + "." + SchemeGroupVersion.String()
+	TaxRuleGroupVersionKind = SchemeGroupVersion.WithKind(TaxRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&TaxRule{}, &TaxRuleList{})
+}
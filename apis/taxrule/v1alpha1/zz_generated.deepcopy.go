@@ -0,0 +1,216 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxRule) DeepCopyInto(out *TaxRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxRule.
+func (in *TaxRule) DeepCopy() *TaxRule {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaxRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxRuleList) DeepCopyInto(out *TaxRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TaxRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxRuleList.
+func (in *TaxRuleList) DeepCopy() *TaxRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TaxRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxRuleObservation) DeepCopyInto(out *TaxRuleObservation) {
+	*out = *in
+	if in.CustomerTaxClassIDs != nil {
+		in, out := &in.CustomerTaxClassIDs, &out.CustomerTaxClassIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProductTaxClassIDs != nil {
+		in, out := &in.ProductTaxClassIDs, &out.ProductTaxClassIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.TaxRateIDs != nil {
+		in, out := &in.TaxRateIDs, &out.TaxRateIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxRuleObservation.
+func (in *TaxRuleObservation) DeepCopy() *TaxRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxRuleParameters) DeepCopyInto(out *TaxRuleParameters) {
+	*out = *in
+	if in.CustomerTaxClassIDs != nil {
+		in, out := &in.CustomerTaxClassIDs, &out.CustomerTaxClassIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomerTaxClassIDRefs != nil {
+		in, out := &in.CustomerTaxClassIDRefs, &out.CustomerTaxClassIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomerTaxClassIDSelector != nil {
+		in, out := &in.CustomerTaxClassIDSelector, &out.CustomerTaxClassIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProductTaxClassIDs != nil {
+		in, out := &in.ProductTaxClassIDs, &out.ProductTaxClassIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProductTaxClassIDRefs != nil {
+		in, out := &in.ProductTaxClassIDRefs, &out.ProductTaxClassIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProductTaxClassIDSelector != nil {
+		in, out := &in.ProductTaxClassIDSelector, &out.ProductTaxClassIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TaxRateIDs != nil {
+		in, out := &in.TaxRateIDs, &out.TaxRateIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TaxRateIDRefs != nil {
+		in, out := &in.TaxRateIDRefs, &out.TaxRateIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TaxRateIDSelector != nil {
+		in, out := &in.TaxRateIDSelector, &out.TaxRateIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxRuleParameters.
+func (in *TaxRuleParameters) DeepCopy() *TaxRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxRuleSpec) DeepCopyInto(out *TaxRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxRuleSpec.
+func (in *TaxRuleSpec) DeepCopy() *TaxRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaxRuleStatus) DeepCopyInto(out *TaxRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaxRuleStatus.
+func (in *TaxRuleStatus) DeepCopy() *TaxRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TaxRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
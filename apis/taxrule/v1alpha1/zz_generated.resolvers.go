@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by angryjet. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	v1alpha11 "github.com/web-seven/provider-magento/apis/taxclass/v1alpha1"
+	v1alpha12 "github.com/web-seven/provider-magento/apis/taxrate/v1alpha1"
+	v1alpha1 "github.com/web-seven/provider-magento/apis/v1alpha1"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this TaxRule.
+func (mg *TaxRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.CustomerTaxClassIDs,
+		Extract:       v1alpha1.ExternalID(),
+		References:    mg.Spec.ForProvider.CustomerTaxClassIDRefs,
+		Selector:      mg.Spec.ForProvider.CustomerTaxClassIDSelector,
+		To: reference.To{
+			List:    &v1alpha11.TaxClassList{},
+			Managed: &v1alpha11.TaxClass{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomerTaxClassIDs")
+	}
+	mg.Spec.ForProvider.CustomerTaxClassIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.CustomerTaxClassIDRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ProductTaxClassIDs,
+		Extract:       v1alpha1.ExternalID(),
+		References:    mg.Spec.ForProvider.ProductTaxClassIDRefs,
+		Selector:      mg.Spec.ForProvider.ProductTaxClassIDSelector,
+		To: reference.To{
+			List:    &v1alpha11.TaxClassList{},
+			Managed: &v1alpha11.TaxClass{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ProductTaxClassIDs")
+	}
+	mg.Spec.ForProvider.ProductTaxClassIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ProductTaxClassIDRefs = mrsp.ResolvedReferences
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.TaxRateIDs,
+		Extract:       v1alpha1.ExternalID(),
+		References:    mg.Spec.ForProvider.TaxRateIDRefs,
+		Selector:      mg.Spec.ForProvider.TaxRateIDSelector,
+		To: reference.To{
+			List:    &v1alpha12.TaxRateList{},
+			Managed: &v1alpha12.TaxRate{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.TaxRateIDs")
+	}
+	mg.Spec.ForProvider.TaxRateIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.TaxRateIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
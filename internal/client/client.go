@@ -9,6 +9,8 @@ type Client struct {
 	BaseURL     string
 	AccessToken string
 	Path        string
+	Key         string
+	IDKey       string
 }
 
 // NewClient initializes a new Magento API client configuration
@@ -16,6 +18,7 @@ func NewClient(baseURL, accessToken string) *Client {
 	return &Client{
 		BaseURL:     baseURL,
 		AccessToken: accessToken,
+		IDKey:       "id",
 	}
 }
 
@@ -1,22 +1,108 @@
 package magento
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 const (
 	separator = "/"
 )
 
+// Suffixes of forProvider fields which are resolved by the provider and never
+// sent to Magento.
+var providerOnlySuffixes = []string{"Ref", "Refs", "Selector"}
+
+// ErrNotFound is returned if a resource does not exist at specified api
+// endpoint.
+var ErrNotFound = errors.New("resource not found")
+
+// ErrNoForProvider is returned if the observed resource has no
+// spec.forProvider object.
+var ErrNoForProvider = errors.New("managed resource has no spec.forProvider")
+
+// ErrUnreadableResponse is returned if a request succeeded but its response
+// could not be read.
+var ErrUnreadableResponse = errors.New("cannot read response")
+
+// An APIError is an error response of the Magento API.
+type APIError struct {
+	StatusCode int
+	// Message of the error with its parameters filled in.
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("Magento responded with status %d", e.StatusCode)
+	}
+	return e.Message
+}
+
+// newAPIError reads the error of a Magento response, whose message refers to
+// its parameters by position like %1, or by name like %fieldName.
+func newAPIError(resp *resty.Response) error {
+	var body struct {
+		Message    string      `json:"message"`
+		Parameters interface{} `json:"parameters"`
+	}
+	if err := json.Unmarshal(resp.Body(), &body); err != nil || body.Message == "" {
+		return &APIError{StatusCode: resp.StatusCode(), Message: strings.TrimSpace(resp.String())}
+	}
+	msg := body.Message
+	switch params := body.Parameters.(type) {
+	case []interface{}:
+		// Replace higher positions first, so %1 does not match %10.
+		for i := len(params) - 1; i >= 0; i-- {
+			msg = strings.ReplaceAll(msg, fmt.Sprintf("%%%d", i+1), fmt.Sprint(params[i]))
+		}
+	case map[string]interface{}:
+		for name, v := range params {
+			msg = strings.ReplaceAll(msg, "%"+name, fmt.Sprint(v))
+		}
+	}
+	return &APIError{StatusCode: resp.StatusCode(), Message: msg}
+}
+
+// IsInvalid returns true if Magento rejected the request body, like a
+// resource failing validation. Sending it again fails the same way.
+func IsInvalid(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest
+}
+
+// IsUnreadableResponse returns true if a request succeeded but its response
+// could not be read.
+func IsUnreadableResponse(err error) bool {
+	return errors.Is(err, ErrUnreadableResponse)
+}
+
+// IsNotFound returns true if the error reports a missing resource.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
 // Desired represents the desired state of a resource.
 type Desired struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID   json.Number `json:"id"`
+	Name string      `json:"name"`
+	// Fields of the resource as returned by Magento.
+	Fields map[string]interface{} `json:"-"`
+}
+
+// resourcePath returns the path of the resource with the ID at specified api
+// endpoint. The ID is escaped, as IDs like SKUs may contain slashes.
+func resourcePath(c *Client, id string) string {
+	return c.Path + separator + url.PathEscape(id)
 }
 
 // GetResourceByID retrieves a resource by its ID at specified api endpoint.
@@ -24,57 +110,273 @@ func GetResourceByID(c *Client, id string) (*Desired, error) {
 	if id == "" {
 		return nil, errors.New("resource with ID" + id + " in " + c.Path + " not found")
 	}
-	resp, _ := c.Create().R().Get(c.Path + separator + id)
+	resp, err := c.Create().R().Get(resourcePath(c, id))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, resourcePath(c, id))
+	}
 	if resp.StatusCode() != http.StatusOK {
-		return nil, errors.New("resource in " + c.Path + " not found")
+		return nil, newAPIError(resp)
 	}
 
-	var resource *Desired
-	err := json.Unmarshal(resp.Body(), &resource)
-	if err != nil {
+	var resource map[string]interface{}
+	if err := decode(resp.Body(), &resource); err != nil {
 		return nil, errors.New("failed to unmarshal response body")
 	}
+	name, _ := resource["name"].(string)
 
-	return resource, nil
+	return &Desired{ID: json.Number(fmt.Sprint(resource[c.IDKey])), Name: name, Fields: resource}, nil
 }
 
-// CreateResource creates a new resource at specified api endpoint.
-func CreateResource(c *Client, observed map[string]interface{}) (map[string]interface{}, *resty.Response, error) {
+// decode unmarshals a response body keeping numbers, like IDs, as they are.
+func decode(body []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+// RequestBody wraps forProvider of the observed resource into the body
+// expected by create and update calls at specified api endpoint. Omitted
+// fields are left out in addition to references and selectors.
+func RequestBody(c *Client, observed map[string]interface{}, omit ...string) (map[string]interface{}, error) {
+	declared, err := forProvider(observed)
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]interface{}{}
+	for k, v := range declared {
+		if !isProviderOnly(k) && !contains(omit, k) {
+			body[k] = v
+		}
+	}
+	return map[string]interface{}{
+		c.Key: body,
+	}, nil
+}
+
+// forProvider returns spec.forProvider of the observed resource.
+func forProvider(observed map[string]interface{}) (map[string]interface{}, error) {
+	v, found, err := unstructured.NestedFieldNoCopy(observed, "spec", "forProvider")
+	if err != nil {
+		return nil, err
+	}
+	declared, ok := v.(map[string]interface{})
+	if !found || !ok {
+		return nil, ErrNoForProvider
+	}
+	return declared, nil
+}
+
+// isProviderOnly returns true if the forProvider field is only meaningful to
+// the provider, like references, selectors and Secret references.
+func isProviderOnly(field string) bool {
+	for _, s := range providerOnlySuffixes {
+		if strings.HasSuffix(field, s) {
+			return true
+		}
+	}
+	return false
+}
 
-	requestBody := map[string]interface{}{
-		strings.ToLower(observed["kind"].(string)): observed["spec"].(map[string]interface{})["forProvider"],
+// contains returns true if the field is part of fields.
+func contains(fields []string, field string) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
 	}
+	return false
+}
+
+// CreateResource creates a new resource at specified api endpoint. Resources
+// Magento created but whose response could not be read are reported with
+// ErrUnreadableResponse.
+func CreateResource(c *Client, requestBody map[string]interface{}) (map[string]interface{}, error) {
 	resp, err := c.Create().R().SetHeader("Content-Type", "application/json").SetBody(requestBody).Post(c.Path)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	// Some endpoints do not respond with the created resource.
+	if len(resp.Body()) == 0 {
+		return map[string]interface{}{}, nil
+	}
+	var created interface{}
+	if err := decode(resp.Body(), &created); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnreadableResponse, err)
+	}
+	desired, ok := created.(map[string]interface{})
+	if !ok {
+		// Some endpoints respond with the bare ID of the created resource.
+		desired = map[string]interface{}{c.IDKey: created}
+	}
+
+	return desired, nil
+}
+
+// UpdateResourceByID updates a resource by its ID at specified api endpoint
+// and returns the updated resource, or nil if Magento did not respond with it.
+func UpdateResourceByID(c *Client, id string, requestBody map[string]interface{}) (map[string]interface{}, error) {
+	return update(c, resourcePath(c, id), requestBody)
+}
+
+// UpdateResource updates a resource whose ID is part of the request body
+// rather than the path at specified api endpoint, and returns the updated
+// resource like UpdateResourceByID.
+func UpdateResource(c *Client, id string, requestBody map[string]interface{}) (map[string]interface{}, error) {
+	if resource, ok := requestBody[c.Key].(map[string]interface{}); ok {
+		resource[c.IDKey] = id
+	}
+	return update(c, c.Path, requestBody)
+}
+
+// update sends the request body to specified path and returns the updated
+// resource if Magento responded with it. A response which is not JSON is
+// reported as ErrUnreadableResponse.
+func update(c *Client, path string, requestBody map[string]interface{}) (map[string]interface{}, error) {
+	resp, err := c.Create().R().SetHeader("Content-Type", "application/json").SetBody(requestBody).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	// Some endpoints respond with a flag or an ID rather than the resource,
+	// which is observed again anyway.
+	if len(resp.Body()) == 0 {
+		return nil, nil
+	}
+	var updated interface{}
+	if err := decode(resp.Body(), &updated); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnreadableResponse, err)
+	}
+	resource, _ := updated.(map[string]interface{})
+	return resource, nil
+}
+
+// A Filter is a search criteria filter on a field of the resources.
+type Filter struct {
+	Field string
+	Value string
+	// ConditionType like eq or like.
+	ConditionType string
+}
+
+// SearchResources lists the resources matching all filters at specified api
+// endpoint, using Magento search criteria.
+func SearchResources(c *Client, filters map[string]string) ([]map[string]interface{}, error) {
+	fields := make([]string, 0, len(filters))
+	for field := range filters {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	search := make([]Filter, 0, len(fields))
+	for _, field := range fields {
+		search = append(search, Filter{Field: field, Value: filters[field], ConditionType: "eq"})
+	}
+	return Search(c, search...)
+}
+
+// Search lists the resources matching all filters at specified api endpoint.
+func Search(c *Client, filters ...Filter) ([]map[string]interface{}, error) {
+	params := map[string]string{}
+	for i, f := range filters {
+		filter := fmt.Sprintf("searchCriteria[filterGroups][%d][filters][0]", i)
+		params[filter+"[field]"] = f.Field
+		params[filter+"[value]"] = f.Value
+		params[filter+"[conditionType]"] = f.ConditionType
+	}
+	resp, err := c.Create().R().SetQueryParams(params).Get(c.Path)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newAPIError(resp)
+	}
+
+	var result struct {
+		Items []map[string]interface{} `json:"items"`
 	}
+	if err := decode(resp.Body(), &result); err != nil {
+		return nil, err
+	}
+
+	return result.Items, nil
+}
 
-	var desired map[string]interface{}
-	err = json.Unmarshal(resp.Body(), &desired)
+// Get retrieves specified api endpoint and decodes the response into v.
+func Get(c *Client, v interface{}) error {
+	resp, err := c.Create().R().Get(c.Path)
 	if err != nil {
-		return nil, nil, err
+		return err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrNotFound, c.Path)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return newAPIError(resp)
 	}
 
-	return desired, resp, nil
+	return decode(resp.Body(), v)
 }
 
-// UpdateResourceByID updates a resource by its ID at specified api endpoint.
-func UpdateResourceByID(c *Client, id string, observed map[string]interface{}) error {
-	requestBody := map[string]interface{}{
-		strings.ToLower(observed["kind"].(string)): observed["spec"].(map[string]interface{})["forProvider"],
+// Post sends the request body to specified api endpoint and decodes the
+// response into v, which may be nil.
+func Post(c *Client, requestBody interface{}, v interface{}) error {
+	resp, err := c.Create().R().SetHeader("Content-Type", "application/json").SetBody(requestBody).Post(c.Path)
+	if err != nil {
+		return err
 	}
-	_, err := c.Create().R().SetBody(requestBody).Put(c.Path + separator + id)
+	if resp.StatusCode() != http.StatusOK {
+		return newAPIError(resp)
+	}
+	if v == nil {
+		return nil
+	}
+
+	return decode(resp.Body(), v)
+}
+
+// Put sends the request body to specified api endpoint and decodes the
+// response into v, which may be nil.
+func Put(c *Client, requestBody interface{}, v interface{}) error {
+	resp, err := c.Create().R().SetHeader("Content-Type", "application/json").SetBody(requestBody).Put(c.Path)
 	if err != nil {
 		return err
 	}
+	if resp.StatusCode() != http.StatusOK {
+		return newAPIError(resp)
+	}
+	if v == nil {
+		return nil
+	}
 
-	return nil
+	return decode(resp.Body(), v)
 }
 
 // DeleteResourceByID deletes a resource by its ID at specified api endpoint.
+// Resources which do not exist are deleted already.
 func DeleteResourceByID(c *Client, id string) error {
-	_, err := c.Create().R().Delete(c.Path + separator + id)
-	return err
+	resp, err := c.Create().R().Delete(resourcePath(c, id))
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return nil
+	case resp.StatusCode() != http.StatusOK:
+		return newAPIError(resp)
+	case strings.TrimSpace(resp.String()) == "false":
+		return &APIError{StatusCode: resp.StatusCode(), Message: "Magento did not delete " + resourcePath(c, id)}
+	}
+	return nil
 }
 
 // IsUpToDate checks if the observed resource is up to date with the desired resource.
@@ -83,7 +385,12 @@ func IsUpToDate(observed map[string]interface{}, desired *Desired) (bool, error)
 	if observed == nil || desired == nil {
 		return false, errors.New("observed or desired resource is nil")
 	}
-	if observed["spec"].(map[string]interface{})["forProvider"].(map[string]interface{})["name"] != desired.Name {
+	declared, err := forProvider(observed)
+	if err != nil {
+		return false, err
+	}
+	name, ok := declared["name"]
+	if ok && name != desired.Name {
 		return false, nil
 	}
 
@@ -0,0 +1,149 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	categoryv1alpha1 "github.com/web-seven/provider-magento/apis/category/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotCategory  = "managed resource is not a Category custom resource"
+	errMoveCategory = "cannot move category"
+	errGetCategory  = "cannot get category tree"
+	errNotEmpty     = "category %q has %d children and %d products, set onDelete to Cascade to delete it or to Orphan to leave it in place"
+
+	// Categories up to this level are the tree root and the store roots.
+	rootCategoryLevel = 1
+
+	onDeleteOrphan  = "Orphan"
+	onDeleteCascade = "Cascade"
+)
+
+// categoryObserve records the number of products of a Category, which only
+// the category tree endpoint reports, and reports whether the Category has
+// the declared parent.
+func categoryObserve(_ context.Context, e *external, mg resource.Managed, _ map[string]interface{}) (bool, managed.ConnectionDetails, error) {
+	cr, ok := mg.(*categoryv1alpha1.Category)
+	if !ok {
+		return false, nil, errors.New(errNotCategory)
+	}
+	contents, err := getCategoryContents(e, mg.GetAnnotations()[id], 1)
+	if err != nil {
+		return false, nil, errors.Wrap(err, errGetCategory)
+	}
+	cr.Status.AtProvider.ProductCount = contents.ProductCount
+	parentID := cr.Spec.ForProvider.ParentID
+	return parentID == "" || parentID == strconv.Itoa(cr.Status.AtProvider.ParentID), nil, nil
+}
+
+// categoryMove moves a Category whose declared parent changed, which Magento
+// ignores in regular updates.
+func categoryMove(_ context.Context, e *external, mg resource.Managed, _ map[string]interface{}) error {
+	cr, ok := mg.(*categoryv1alpha1.Category)
+	if !ok {
+		return errors.New(errNotCategory)
+	}
+	if cr.Spec.ForProvider.ParentID == "" || cr.Spec.ForProvider.ParentID == strconv.Itoa(cr.Status.AtProvider.ParentID) {
+		return nil
+	}
+	parentID, err := strconv.Atoi(cr.Spec.ForProvider.ParentID)
+	if err != nil {
+		return errors.Wrap(err, errMoveCategory)
+	}
+	move := map[string]interface{}{"parentId": parentID}
+	if cr.Spec.ForProvider.AfterID != nil {
+		move["afterId"] = *cr.Spec.ForProvider.AfterID
+	}
+	path := "categories/" + mg.GetAnnotations()[id] + "/move"
+	if err := magento.Put(e.endpoint(path), move, nil); err != nil {
+		return errors.Wrap(err, errMoveCategory)
+	}
+	cr.Status.AtProvider.ParentID = parentID
+	return nil
+}
+
+// categoryContents is a category of the category tree endpoint, along with
+// the categories below it.
+type categoryContents struct {
+	ID           int                `json:"id"`
+	Level        int                `json:"level"`
+	ProductCount int                `json:"product_count"`
+	Children     []categoryContents `json:"children_data"`
+}
+
+// getCategoryContents returns a category along with the categories below it,
+// down to the depth or all of them if it is 0.
+func getCategoryContents(e *external, categoryID string, depth int) (*categoryContents, error) {
+	path := "categories?rootCategoryId=" + url.QueryEscape(categoryID)
+	if depth > 0 {
+		path += "&depth=" + strconv.Itoa(depth)
+	}
+	contents := &categoryContents{}
+	if err := magento.Get(e.endpoint(path), contents); err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+// deleteNonEmpty applies the onDelete policy to a category with children or
+// products. It returns true if the category is deleted anyway, false if it is
+// left in place and an error if deleting it is blocked.
+func deleteNonEmpty(onDelete, name string, children, products int) (bool, error) {
+	switch onDelete {
+	case onDeleteCascade:
+		return true, nil
+	case onDeleteOrphan:
+		return false, nil
+	}
+	return false, errors.Errorf(errNotEmpty, name, children, products)
+}
+
+// categoryDeletion guards categories against deleting their children and
+// unassigning their products by accident. Root categories are never deleted.
+func categoryDeletion(_ context.Context, e *external, mg resource.Managed) (bool, error) {
+	cr, ok := mg.(*categoryv1alpha1.Category)
+	if !ok {
+		return false, errors.New(errNotCategory)
+	}
+	categoryID := mg.GetAnnotations()[id]
+	if categoryID == "" {
+		return true, nil
+	}
+	tree, err := getCategoryContents(e, categoryID, 1)
+	if magento.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetCategory)
+	}
+	if tree.Level <= rootCategoryLevel {
+		return false, nil
+	}
+	if len(tree.Children) == 0 && tree.ProductCount == 0 {
+		return true, nil
+	}
+	return deleteNonEmpty(cr.Spec.ForProvider.OnDelete, cr.Spec.ForProvider.Name, len(tree.Children), tree.ProductCount)
+}
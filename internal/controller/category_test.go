@@ -0,0 +1,186 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	categoryv1alpha1 "github.com/web-seven/provider-magento/apis/category/v1alpha1"
+)
+
+func TestCategoryDeletion(t *testing.T) {
+	men := fakeCategory{id: 10, parentID: 2, name: "Men", position: 1}
+	shirts := fakeCategory{id: 12, parentID: 10, name: "Shirts", position: 1}
+	shirtsWithProducts := fakeCategory{id: 12, parentID: 10, name: "Shirts", position: 1, products: 3}
+
+	type args struct {
+		categories []fakeCategory
+		categoryID string
+		name       string
+		onDelete   string
+	}
+
+	type want struct {
+		exists bool
+		err    error
+		writes []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"BlockChildren": {
+			reason: "Deleting a category with children should be blocked by default without deleting anything.",
+			args:   args{categories: []fakeCategory{men, shirts}, categoryID: "10", name: "Men"},
+			want:   want{exists: true, err: errors.Errorf(errNotEmpty, "Men", 1, 0)},
+		},
+		"BlockProducts": {
+			reason: "Deleting a category with products should be blocked by default without deleting anything.",
+			args:   args{categories: []fakeCategory{men, shirtsWithProducts}, categoryID: "12", name: "Shirts", onDelete: "Block"},
+			want:   want{exists: true, err: errors.Errorf(errNotEmpty, "Shirts", 0, 3)},
+		},
+		"Orphan": {
+			reason: "A category with children should be left in place and its finalizer released when orphaning.",
+			args:   args{categories: []fakeCategory{men, shirts}, categoryID: "10", name: "Men", onDelete: onDeleteOrphan},
+			want:   want{exists: false},
+		},
+		"Cascade": {
+			reason: "A category with children should be deleted when cascading.",
+			args:   args{categories: []fakeCategory{men, shirts}, categoryID: "10", name: "Men", onDelete: onDeleteCascade},
+			want:   want{exists: true, writes: []string{"DELETE /rest/V1/categories/10"}},
+		},
+		"Empty": {
+			reason: "A category without children or products should be deleted by default.",
+			args:   args{categories: []fakeCategory{men, shirts}, categoryID: "12", name: "Shirts"},
+			want:   want{exists: true, writes: []string{"DELETE /rest/V1/categories/12"}},
+		},
+		"StoreRoot": {
+			reason: "A store root category at level 1 should never be deleted.",
+			args:   args{categoryID: "2", name: "Default Category", onDelete: onDeleteCascade},
+			want:   want{exists: false},
+		},
+		"RootCatalog": {
+			reason: "The root catalog at level 0 should never be deleted.",
+			args:   args{categoryID: "1", name: "Root Catalog", onDelete: onDeleteCascade},
+			want:   want{exists: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := newMagentoCategoryTree(tc.args.categories...)
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			now := metav1.Now()
+			cr := &categoryv1alpha1.Category{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations:       map[string]string{id: tc.args.categoryID},
+					DeletionTimestamp: &now,
+				},
+				Spec: categoryv1alpha1.CategorySpec{ForProvider: categoryv1alpha1.CategoryParameters{
+					Name:     tc.args.name,
+					OnDelete: tc.args.onDelete,
+				}},
+			}
+			e := testExternal(ts.URL, "categories", categoryv1alpha1.CategoryKind)
+
+			// Like the managed reconciler, only delete categories which
+			// were observed to exist.
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o.ResourceExists {
+				err = e.Delete(context.Background(), cr)
+			}
+			if diff := cmp.Diff(tc.want.exists, o.ResourceExists); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want exists, +got exists:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCategoryObserve(t *testing.T) {
+	men := fakeCategory{id: 10, parentID: 2, name: "Men", position: 1}
+	shirts := fakeCategory{id: 12, parentID: 10, name: "Shirts", position: 1, products: 3}
+	observed := categoryv1alpha1.CategoryObservation{ID: 12, ParentID: 10, Name: "Shirts", IsActive: true, Position: 1, Level: 3, Path: "1/2/10/12", ProductCount: 3}
+
+	type want struct {
+		upToDate   bool
+		atProvider categoryv1alpha1.CategoryObservation
+	}
+
+	cases := map[string]struct {
+		reason   string
+		parentID string
+		want     want
+	}{
+		"SameParent": {
+			reason:   "A category below its declared parent should be up to date, with its products counted by the category tree.",
+			parentID: "10",
+			want:     want{upToDate: true, atProvider: observed},
+		},
+		"ParentChanged": {
+			reason:   "A category whose declared parent changed should not be up to date.",
+			parentID: "2",
+			want:     want{atProvider: observed},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(newMagentoCategoryTree(men, shirts))
+			defer ts.Close()
+
+			cr := &categoryv1alpha1.Category{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "12"}},
+				Spec: categoryv1alpha1.CategorySpec{ForProvider: categoryv1alpha1.CategoryParameters{
+					Name:     "Shirts",
+					ParentID: tc.parentID,
+				}},
+			}
+			e := testExternal(ts.URL, "categories", categoryv1alpha1.CategoryKind)
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, o.ResourceUpToDate); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.atProvider, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want atProvider, +got atProvider:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
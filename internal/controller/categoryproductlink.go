@@ -0,0 +1,125 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	categoryproductlinkv1alpha1 "github.com/web-seven/provider-magento/apis/categoryproductlink/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotCategoryProductLink = "managed resource is not a CategoryProductLink custom resource"
+	errGetCategoryProducts    = "cannot get category products"
+	errAssignProduct          = "cannot assign product to category: "
+	errUnassignProduct        = "cannot unassign product from category: "
+)
+
+// categoryProducts reconcile the products assigned to a category as a set.
+var categoryProducts = &setOps{
+	desired:  categoryProductsDesired,
+	observed: categoryProductsObserved,
+	key:      func(en entry) string { return fmt.Sprintf("%v", en["sku"]) },
+	save:     categoryProductsAssign,
+	remove:   categoryProductsUnassign,
+	record:   categoryProductsRecord,
+}
+
+// categoryProductsDesired returns the declared products of a category.
+func categoryProductsDesired(mg resource.Managed) ([]entry, error) {
+	cr, ok := mg.(*categoryproductlinkv1alpha1.CategoryProductLink)
+	if !ok {
+		return nil, errors.New(errNotCategoryProductLink)
+	}
+	entries := make([]entry, 0, len(cr.Spec.ForProvider.Products))
+	for _, p := range cr.Spec.ForProvider.Products {
+		entries = append(entries, entry{"sku": p.Sku, "position": p.Position})
+	}
+	return entries, nil
+}
+
+// categoryProductsObserved returns the products assigned to a category in
+// Magento.
+func categoryProductsObserved(_ context.Context, e *external, _ resource.Managed) ([]entry, error) {
+	var entries []entry
+	if err := magento.Get(e.service.client, &entries); err != nil {
+		return nil, errors.Wrap(err, errGetCategoryProducts)
+	}
+	return entries, nil
+}
+
+// categoryProductsAssign assigns products to a category, or changes their
+// position.
+func categoryProductsAssign(_ context.Context, e *external, mg resource.Managed, entries []entry) error {
+	cr, ok := mg.(*categoryproductlinkv1alpha1.CategoryProductLink)
+	if !ok {
+		return errors.New(errNotCategoryProductLink)
+	}
+	for _, en := range entries {
+		link := map[string]interface{}{
+			"sku":         en["sku"],
+			"position":    en["position"],
+			"category_id": cr.Spec.ForProvider.CategoryID,
+		}
+		if err := magento.Post(e.service.client, map[string]interface{}{"productLink": link}, nil); err != nil {
+			return errors.Wrap(err, errAssignProduct+fmt.Sprintf("%v", en["sku"]))
+		}
+	}
+	return nil
+}
+
+// categoryProductsUnassign unassigns products from a category.
+func categoryProductsUnassign(_ context.Context, e *external, _ resource.Managed, entries []entry) error {
+	for _, en := range entries {
+		sku := fmt.Sprintf("%v", en["sku"])
+		if err := magento.DeleteResourceByID(e.service.client, sku); err != nil {
+			return errors.Wrap(err, errUnassignProduct+sku)
+		}
+	}
+	return nil
+}
+
+// categoryProductsRecord stores the products of a category in atProvider.
+func categoryProductsRecord(mg resource.Managed, entries []entry) error {
+	cr, ok := mg.(*categoryproductlinkv1alpha1.CategoryProductLink)
+	if !ok {
+		return errors.New(errNotCategoryProductLink)
+	}
+	products := make([]categoryproductlinkv1alpha1.CategoryProduct, 0, len(entries))
+	for _, en := range entries {
+		position, _ := strconv.Atoi(fmt.Sprintf("%v", en["position"]))
+		products = append(products, categoryproductlinkv1alpha1.CategoryProduct{
+			Sku:      fmt.Sprintf("%v", en["sku"]),
+			Position: position,
+		})
+	}
+	sort.Slice(products, func(i, j int) bool {
+		if products[i].Position != products[j].Position {
+			return products[i].Position < products[j].Position
+		}
+		return products[i].Sku < products[j].Sku
+	})
+	cr.Status.AtProvider.Products = products
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	categoryproductlinkv1alpha1 "github.com/web-seven/provider-magento/apis/categoryproductlink/v1alpha1"
+)
+
+func TestCategoryProductLink(t *testing.T) {
+	type want struct {
+		upToDate bool
+		writes   []string
+		products []categoryproductlinkv1alpha1.CategoryProduct
+	}
+
+	observed := []categoryproductlinkv1alpha1.CategoryProduct{{Sku: "tshirt", Position: 1}, {Sku: "tshirt/red", Position: 2}}
+
+	cases := map[string]struct {
+		reason   string
+		products []categoryproductlinkv1alpha1.CategoryProduct
+		want     want
+	}{
+		"UpToDate": {
+			reason:   "A category with the declared products at their positions should be up to date.",
+			products: []categoryproductlinkv1alpha1.CategoryProduct{{Sku: "tshirt/red", Position: 2}, {Sku: "tshirt", Position: 1}},
+			want:     want{upToDate: true, products: observed},
+		},
+		"Assigned": {
+			reason:   "A declared product which is not assigned should be assigned with its position and the category.",
+			products: []categoryproductlinkv1alpha1.CategoryProduct{{Sku: "tshirt", Position: 1}, {Sku: "tshirt/red", Position: 2}, {Sku: "cap", Position: 3}},
+			want: want{
+				writes:   []string{`POST /rest/V1/categories/4/products {"productLink":{"category_id":"4","position":3,"sku":"cap"}}`},
+				products: observed,
+			},
+		},
+		"PositionChanged": {
+			reason:   "A product whose declared position changed should be assigned again at that position.",
+			products: []categoryproductlinkv1alpha1.CategoryProduct{{Sku: "tshirt", Position: 3}, {Sku: "tshirt/red", Position: 2}},
+			want: want{
+				writes:   []string{`POST /rest/V1/categories/4/products {"productLink":{"category_id":"4","position":3,"sku":"tshirt"}}`},
+				products: observed,
+			},
+		},
+		"Unassigned": {
+			reason:   "An assigned product which is not declared should be unassigned at the escaped path of its SKU.",
+			products: []categoryproductlinkv1alpha1.CategoryProduct{{Sku: "tshirt", Position: 1}},
+			want: want{
+				writes:   []string{`DELETE /rest/V1/categories/4/products/tshirt%2Fred`},
+				products: observed,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := &magentoWrites{resources: magentoResources{
+				"/rest/V1/categories/4/products": `[{"sku":"tshirt/red","position":2,"category_id":"4"},{"sku":"tshirt","position":1,"category_id":"4"}]`,
+			}}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			e := testExternal(ts.URL, "categories/4/products", categoryproductlinkv1alpha1.CategoryProductLinkKind)
+			cr := &categoryproductlinkv1alpha1.CategoryProductLink{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "4"}},
+				Spec: categoryproductlinkv1alpha1.CategoryProductLinkSpec{ForProvider: categoryproductlinkv1alpha1.CategoryProductLinkParameters{
+					CategoryID: "4",
+					Products:   tc.products,
+				}},
+			}
+
+			// Like the managed reconciler, only update sets which were
+			// observed not to be up to date.
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !o.ResourceUpToDate {
+				if _, err := e.Update(context.Background(), cr); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.upToDate, o.ResourceUpToDate); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.products, cr.Status.AtProvider.Products); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want products, +got products:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,576 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	categorytreev1alpha1 "github.com/web-seven/provider-magento/apis/categorytree/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotCategoryTree    = "managed resource is not a CategoryTree custom resource"
+	errNoRootCategory     = "category tree has no root category"
+	errGetRootCategory    = "cannot get root category"
+	errListCategories     = "cannot list categories below the root category"
+	errDuplicateSiblings  = "category tree declares more than one category named "
+	errCreateTreeCategory = "cannot create category "
+	errUpdateTreeCategory = "cannot update category "
+	errMoveTreeCategory   = "cannot move category "
+	errDeleteTreeCategory = "cannot delete category "
+	errGetTreeContents    = "cannot get the products of the categories below the root category"
+)
+
+// treeNode is a declared category of a CategoryTree, on any level.
+type treeNode struct {
+	Name          string     `json:"name"`
+	IsActive      *bool      `json:"isActive,omitempty"`
+	IncludeInMenu *bool      `json:"includeInMenu,omitempty"`
+	Children      []treeNode `json:"children,omitempty"`
+}
+
+// active returns whether the declared category is active, which it is by
+// default.
+func (n treeNode) active() bool {
+	return n.IsActive == nil || *n.IsActive
+}
+
+// inMenu returns whether the declared category is included in the menu,
+// which it is by default.
+func (n treeNode) inMenu() bool {
+	return n.IncludeInMenu == nil || *n.IncludeInMenu
+}
+
+// treeCategory is a category below the root category in Magento.
+type treeCategory struct {
+	id            int
+	parentID      int
+	name          string
+	position      int
+	isActive      bool
+	includeInMenu bool
+	path          string
+	level         int
+}
+
+// matches returns true if the category has the declared fields at the
+// position.
+func (c *treeCategory) matches(n treeNode, position int) bool {
+	return c.isActive == n.active() && c.includeInMenu == n.inMenu() && c.position == position
+}
+
+// descendantOf returns true if the category is below the category with the
+// ID.
+func (c *treeCategory) descendantOf(id int) bool {
+	return strings.Contains(c.path+"/", "/"+strconv.Itoa(id)+"/")
+}
+
+// categoryTree reconciles a CategoryTree category by category.
+type categoryTree struct {
+	e  *external
+	cr *categorytreev1alpha1.CategoryTree
+
+	rootID   int
+	rootPath string
+	declared []treeNode
+	// byID and byParent index the categories below the root category.
+	byID     map[int]*treeCategory
+	byParent map[int][]*treeCategory
+	// placed are the categories found at their declared path of names,
+	// while matched are the IDs of all declared categories.
+	placed  map[string]*treeCategory
+	matched map[int]bool
+}
+
+// newCategoryTree returns the reconciler of CategoryTree resources.
+func newCategoryTree(e *external) managed.ExternalClient {
+	return &categoryTree{e: e}
+}
+
+// load reads the declared tree and the categories below the root category,
+// and finds the declared categories which are in place.
+func (t *categoryTree) load(mg resource.Managed) error {
+	cr, ok := mg.(*categorytreev1alpha1.CategoryTree)
+	if !ok {
+		return errors.New(errNotCategoryTree)
+	}
+	t.cr = cr
+	rootID, err := strconv.Atoi(cr.Spec.ForProvider.RootCategoryID)
+	if err != nil {
+		return errors.Wrap(err, errNoRootCategory)
+	}
+	t.rootID = rootID
+
+	// All tree levels share their fields, so the declared tree is decoded
+	// into a single recursive type.
+	b, err := json.Marshal(cr.Spec.ForProvider.Categories)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, &t.declared); err != nil {
+		return err
+	}
+	if err := checkSiblings(t.declared, ""); err != nil {
+		return err
+	}
+
+	root, err := magento.GetResourceByID(t.e.service.client, cr.Spec.ForProvider.RootCategoryID)
+	if err != nil {
+		return errors.Wrap(err, errGetRootCategory)
+	}
+	t.rootPath, _ = root.Fields["path"].(string)
+	items, err := magento.Search(t.e.endpoint("categories/list"), magento.Filter{
+		Field:         "path",
+		Value:         t.rootPath + "/%",
+		ConditionType: "like",
+	})
+	if err != nil {
+		return errors.Wrap(err, errListCategories)
+	}
+
+	t.byID = make(map[int]*treeCategory, len(items))
+	t.byParent = map[int][]*treeCategory{}
+	for _, item := range items {
+		c := &treeCategory{
+			id:            remoteInt(item, "id"),
+			parentID:      remoteInt(item, "parent_id"),
+			position:      remoteInt(item, "position"),
+			isActive:      remoteBool(item["is_active"]),
+			includeInMenu: remoteBool(item["include_in_menu"]),
+			level:         remoteInt(item, "level"),
+		}
+		c.name, _ = item["name"].(string)
+		c.path, _ = item["path"].(string)
+		t.byID[c.id] = c
+		t.byParent[c.parentID] = append(t.byParent[c.parentID], c)
+	}
+	for _, children := range t.byParent {
+		sort.Slice(children, func(i, j int) bool { return children[i].position < children[j].position })
+	}
+
+	t.placed = map[string]*treeCategory{}
+	t.matched = map[int]bool{}
+	t.place(t.declared, t.rootID, "")
+	return nil
+}
+
+// checkSiblings returns an error if siblings share a name, as categories are
+// identified by their name among their siblings.
+func checkSiblings(nodes []treeNode, path string) error {
+	names := map[string]bool{}
+	for _, n := range nodes {
+		if names[n.Name] {
+			return errors.New(errDuplicateSiblings + strconv.Quote(treePath(path, n.Name)))
+		}
+		names[n.Name] = true
+		if err := checkSiblings(n.Children, treePath(path, n.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// treePath appends a name to a path of names.
+func treePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + separator + name
+}
+
+// place finds the declared categories which are below their declared parent.
+func (t *categoryTree) place(nodes []treeNode, parentID int, path string) {
+	for _, n := range nodes {
+		c := t.child(parentID, n.Name)
+		if c == nil {
+			continue
+		}
+		t.placed[treePath(path, n.Name)] = c
+		t.matched[c.id] = true
+		t.place(n.Children, c.id, treePath(path, n.Name))
+	}
+}
+
+// child returns the unmatched child of a category with the name.
+func (t *categoryTree) child(parentID int, name string) *treeCategory {
+	for _, c := range t.byParent[parentID] {
+		if c.name == name && !t.matched[c.id] {
+			return c
+		}
+	}
+	return nil
+}
+
+// elsewhere returns an unmatched category with the name which can be moved
+// below the parent.
+func (t *categoryTree) elsewhere(parent *treeCategory, name string) *treeCategory {
+	ids := make([]int, 0, len(t.byID))
+	for id := range t.byID {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		c := t.byID[id]
+		if c.name != name || t.matched[c.id] {
+			continue
+		}
+		if parent != nil && (parent.id == c.id || parent.descendantOf(c.id)) {
+			continue
+		}
+		return c
+	}
+	return nil
+}
+
+// upToDate returns true if all declared categories are in place with the
+// declared fields and, when pruning, no other categories exist.
+func (t *categoryTree) upToDate(nodes []treeNode, path string) bool {
+	for i, n := range nodes {
+		c, ok := t.placed[treePath(path, n.Name)]
+		if !ok || !c.matches(n, i+1) || !t.upToDate(n.Children, treePath(path, n.Name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// observed returns the declared categories in place, in tree order.
+func (t *categoryTree) observed(nodes []treeNode, path string) []categorytreev1alpha1.ObservedCategory {
+	var observed []categorytreev1alpha1.ObservedCategory
+	for _, n := range nodes {
+		c, ok := t.placed[treePath(path, n.Name)]
+		if !ok {
+			continue
+		}
+		observed = append(observed, categorytreev1alpha1.ObservedCategory{Path: treePath(path, n.Name), ID: c.id})
+		observed = append(observed, t.observed(n.Children, treePath(path, n.Name))...)
+	}
+	return observed
+}
+
+// undeclared returns the topmost categories below the root category which
+// are not declared. Store root categories are never pruned.
+func (t *categoryTree) undeclared() []*treeCategory {
+	var undeclared []*treeCategory
+	for _, c := range t.byID {
+		if c.level <= rootCategoryLevel {
+			continue
+		}
+		if !t.matched[c.id] && (c.parentID == t.rootID || t.matched[c.parentID]) {
+			undeclared = append(undeclared, c)
+		}
+	}
+	sort.Slice(undeclared, func(i, j int) bool { return undeclared[i].id < undeclared[j].id })
+	return undeclared
+}
+
+// Observe compares the declared categories with the categories below the
+// root category. The tree exists once it was created and as long as any of
+// its top level categories exists. A deleted tree is gone once none of its
+// categories is left to delete.
+func (t *categoryTree) Observe(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if err := t.load(mg); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Categories the onDelete policy keeps are left in place. Errors
+	// blocking the deletion are surfaced by Delete.
+	if meta.WasDeleted(mg) {
+		if deletions, err := t.deletions(); err == nil && len(deletions) == 0 {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	}
+	topLevel := false
+	for _, n := range t.declared {
+		if _, ok := t.placed[n.Name]; ok {
+			topLevel = true
+		}
+	}
+	created := mg.GetAnnotations()[id] != "" && !meta.WasDeleted(mg)
+	if !topLevel && !created {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	t.cr.Status.AtProvider.Categories = t.observed(t.declared, "")
+	upToDate := t.upToDate(t.declared, "")
+	if t.cr.Spec.ForProvider.Prune && len(t.undeclared()) > 0 {
+		upToDate = false
+	}
+	mg.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// Create creates the declared categories below the root category.
+func (t *categoryTree) Create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if err := t.apply(mg); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.AddAnnotations(mg, map[string]string{id: strconv.Itoa(t.rootID)})
+	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, nil
+}
+
+// Update converges the categories below the root category to the declared
+// tree.
+func (t *categoryTree) Update(_ context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}}, t.apply(mg)
+}
+
+// Delete deletes the declared categories, children first. Categories with
+// products or undeclared children are deleted, left in place or block the
+// deletion according to the onDelete policy, which deletes nothing until
+// none of the categories is blocked. Root categories are never deleted.
+func (t *categoryTree) Delete(_ context.Context, mg resource.Managed) error {
+	mg.SetConditions(xpv1.Deleting())
+	if err := t.load(mg); err != nil {
+		return err
+	}
+	deletions, err := t.deletions()
+	if err != nil {
+		return err
+	}
+	for _, c := range deletions {
+		if err := magento.DeleteResourceByID(t.e.service.client, strconv.Itoa(c.id)); err != nil {
+			return errors.Wrap(err, errDeleteTreeCategory+c.name)
+		}
+	}
+	return nil
+}
+
+// deletions returns the declared categories to delete, children first,
+// according to the onDelete policy.
+func (t *categoryTree) deletions() ([]*treeCategory, error) {
+	contents, err := getCategoryContents(t.e, strconv.Itoa(t.rootID), 0)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetTreeContents)
+	}
+	products := map[int]int{}
+	countProducts(*contents, products)
+
+	var deletions []*treeCategory
+	for _, n := range t.declared {
+		if _, err := t.nodeDeletions(n, "", products, &deletions); err != nil {
+			return nil, err
+		}
+	}
+	return deletions, nil
+}
+
+// countProducts indexes the number of products of the category and the
+// categories below it by ID.
+func countProducts(c categoryContents, products map[int]int) {
+	products[c.ID] = c.ProductCount
+	for _, child := range c.Children {
+		countProducts(child, products)
+	}
+}
+
+// nodeDeletions adds the declared category to the categories to delete
+// after its declared children, unless the onDelete policy leaves it in
+// place. It returns true if the category is gone once the deletions are
+// done.
+func (t *categoryTree) nodeDeletions(n treeNode, path string, products map[int]int, deletions *[]*treeCategory) (bool, error) {
+	name := treePath(path, n.Name)
+	c, ok := t.placed[name]
+	if !ok {
+		return true, nil
+	}
+	root := c.level <= rootCategoryLevel
+	onDelete := t.cr.Spec.ForProvider.OnDelete
+	if onDelete == onDeleteCascade && !root {
+		*deletions = append(*deletions, c)
+		return true, nil
+	}
+	children := 0
+	for _, child := range n.Children {
+		deleted, err := t.nodeDeletions(child, name, products, deletions)
+		if err != nil {
+			return false, err
+		}
+		if !deleted {
+			children++
+		}
+	}
+	if root {
+		return false, nil
+	}
+	for _, child := range t.byParent[c.id] {
+		if !t.matched[child.id] {
+			children++
+		}
+	}
+	if children > 0 || products[c.id] > 0 {
+		if del, err := deleteNonEmpty(onDelete, name, children, products[c.id]); err != nil || !del {
+			return false, err
+		}
+	}
+	*deletions = append(*deletions, c)
+	return true, nil
+}
+
+// apply creates, moves and updates categories top down until the declared
+// tree is in place, and prunes undeclared categories if configured to.
+func (t *categoryTree) apply(mg resource.Managed) error {
+	if err := t.load(mg); err != nil {
+		return err
+	}
+	if err := t.applyNodes(t.declared, nil, ""); err != nil {
+		return err
+	}
+	if !t.cr.Spec.ForProvider.Prune {
+		return nil
+	}
+	for _, c := range t.undeclared() {
+		if err := magento.DeleteResourceByID(t.e.service.client, strconv.Itoa(c.id)); err != nil {
+			return errors.Wrap(err, errDeleteTreeCategory+c.name)
+		}
+	}
+	return nil
+}
+
+// applyNodes puts the declared categories in place below the parent, which
+// is the root category if nil.
+func (t *categoryTree) applyNodes(nodes []treeNode, parent *treeCategory, path string) error {
+	parentID := t.rootID
+	if parent != nil {
+		parentID = parent.id
+	}
+	afterID := 0
+	for i, n := range nodes {
+		name := treePath(path, n.Name)
+		c, ok := t.placed[name]
+		if !ok {
+			c = t.child(parentID, n.Name)
+		}
+		switch {
+		case c != nil:
+			if !c.matches(n, i+1) {
+				if err := t.update(c, n, i+1); err != nil {
+					return err
+				}
+			}
+		default:
+			// Only a pruned tree owns all categories below the root, so only
+			// then same-named categories elsewhere are moved into place.
+			if t.cr.Spec.ForProvider.Prune {
+				c = t.elsewhere(parent, n.Name)
+			}
+			if c != nil {
+				if err := t.move(c, parentID, afterID); err != nil {
+					return err
+				}
+				if err := t.update(c, n, i+1); err != nil {
+					return err
+				}
+				break
+			}
+			created, err := t.create(n, parentID, i+1)
+			if err != nil {
+				return err
+			}
+			c = created
+		}
+		t.placed[name] = c
+		t.matched[c.id] = true
+		if err := t.applyNodes(n.Children, c, name); err != nil {
+			return err
+		}
+		afterID = c.id
+	}
+	return nil
+}
+
+// categoryBody returns the request body writing the declared fields of a
+// category.
+func categoryBody(n treeNode, position int) map[string]interface{} {
+	return map[string]interface{}{
+		"name":          n.Name,
+		"isActive":      n.active(),
+		"includeInMenu": n.inMenu(),
+		"position":      position,
+	}
+}
+
+// create creates a declared category below the parent.
+func (t *categoryTree) create(n treeNode, parentID int, position int) (*treeCategory, error) {
+	body := categoryBody(n, position)
+	body["parentId"] = parentID
+	created, err := magento.CreateResource(t.e.service.client, map[string]interface{}{t.e.service.client.Key: body})
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateTreeCategory+n.Name)
+	}
+	c := &treeCategory{
+		id:            remoteInt(created, "id"),
+		parentID:      parentID,
+		name:          n.Name,
+		position:      position,
+		isActive:      n.active(),
+		includeInMenu: n.inMenu(),
+		level:         remoteInt(created, "level"),
+	}
+	if c.id == 0 {
+		return nil, errors.New(errCreateTreeCategory + n.Name)
+	}
+	c.path, _ = created["path"].(string)
+	t.byID[c.id] = c
+	return c, nil
+}
+
+// update writes the declared fields of a category.
+func (t *categoryTree) update(c *treeCategory, n treeNode, position int) error {
+	body := categoryBody(n, position)
+	body["id"] = c.id
+	endpoint := t.e.endpoint("categories" + separator + strconv.Itoa(c.id))
+	if err := magento.Put(endpoint, map[string]interface{}{t.e.service.client.Key: body}, nil); err != nil {
+		return errors.Wrap(err, errUpdateTreeCategory+n.Name)
+	}
+	c.position = position
+	c.isActive = n.active()
+	c.includeInMenu = n.inMenu()
+	return nil
+}
+
+// move moves a category below the parent, after the sibling with the ID or
+// first if it is 0.
+func (t *categoryTree) move(c *treeCategory, parentID int, afterID int) error {
+	endpoint := t.e.endpoint(fmt.Sprintf("categories/%d/move", c.id))
+	if err := magento.Put(endpoint, map[string]interface{}{"parentId": parentID, "afterId": afterID}, nil); err != nil {
+		return errors.Wrap(err, errMoveTreeCategory+c.name)
+	}
+	c.parentID = parentID
+	if parent, ok := t.byID[parentID]; ok {
+		c.path = parent.path + separator + strconv.Itoa(c.id)
+	} else {
+		c.path = t.rootPath + separator + strconv.Itoa(c.id)
+	}
+	return nil
+}
@@ -0,0 +1,551 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	categorytreev1alpha1 "github.com/web-seven/provider-magento/apis/categorytree/v1alpha1"
+)
+
+// fakeCategory is a category of the magentoCategoryTree fake.
+type fakeCategory struct {
+	id       int
+	parentID int
+	name     string
+	position int
+	products int
+}
+
+// magentoCategoryTree fakes the category endpoints of Magento on top of a
+// tree of categories below the root catalog 1 and the store root 2, and
+// records write requests.
+type magentoCategoryTree struct {
+	mu         sync.Mutex
+	categories map[int]*fakeCategory
+	nextID     int
+	writes     []string
+}
+
+func newMagentoCategoryTree(categories ...fakeCategory) *magentoCategoryTree {
+	m := &magentoCategoryTree{
+		categories: map[int]*fakeCategory{
+			1: {id: 1, name: "Root Catalog"},
+			2: {id: 2, parentID: 1, name: "Default Category", position: 1},
+		},
+		nextID: 100,
+	}
+	for i := range categories {
+		c := categories[i]
+		m.categories[c.id] = &c
+	}
+	return m
+}
+
+func (m *magentoCategoryTree) path(c *fakeCategory) string {
+	if c.parentID == 0 {
+		return strconv.Itoa(c.id)
+	}
+	return m.path(m.categories[c.parentID]) + "/" + strconv.Itoa(c.id)
+}
+
+func (m *magentoCategoryTree) level(c *fakeCategory) int {
+	return strings.Count(m.path(c), "/")
+}
+
+func (m *magentoCategoryTree) children(id int) []*fakeCategory {
+	var children []*fakeCategory
+	for _, c := range m.categories {
+		if c.parentID == id && c.id != id {
+			children = append(children, c)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		if children[i].position != children[j].position {
+			return children[i].position < children[j].position
+		}
+		return children[i].id < children[j].id
+	})
+	return children
+}
+
+func (m *magentoCategoryTree) fields(c *fakeCategory) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              c.id,
+		"parent_id":       c.parentID,
+		"name":            c.name,
+		"position":        c.position,
+		"level":           m.level(c),
+		"path":            m.path(c),
+		"is_active":       true,
+		"include_in_menu": true,
+	}
+}
+
+func (m *magentoCategoryTree) contents(c *fakeCategory) map[string]interface{} {
+	children := []interface{}{}
+	for _, child := range m.children(c.id) {
+		children = append(children, m.contents(child))
+	}
+	return map[string]interface{}{"id": c.id, "level": m.level(c), "product_count": c.products, "children_data": children}
+}
+
+func (m *magentoCategoryTree) delete(id int) {
+	for _, child := range m.children(id) {
+		m.delete(child.id)
+	}
+	delete(m.categories, id)
+}
+
+// tree returns the paths of names below the category, in tree order.
+func (m *magentoCategoryTree) tree(id int, path string) []string {
+	var paths []string
+	for _, c := range m.children(id) {
+		paths = append(paths, treePath(path, c.name))
+		paths = append(paths, m.tree(c.id, treePath(path, c.name))...)
+	}
+	return paths
+}
+
+func (m *magentoCategoryTree) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r.Method != http.MethodGet {
+		m.writes = append(m.writes, r.Method+" "+r.URL.Path)
+	}
+	var body map[string]interface{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	category, _ := body["category"].(map[string]interface{})
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/rest/V1/categories"), "/")
+	var c *fakeCategory
+	if len(parts) > 1 {
+		id, _ := strconv.Atoi(parts[1])
+		c = m.categories[id]
+	}
+	respond := func(v interface{}) {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/V1/categories/list":
+		prefix := strings.TrimSuffix(r.URL.Query().Get("searchCriteria[filterGroups][0][filters][0][value]"), "%")
+		items := []interface{}{}
+		for _, c := range m.categories {
+			if strings.HasPrefix(m.path(c), prefix) {
+				items = append(items, m.fields(c))
+			}
+		}
+		respond(map[string]interface{}{"items": items})
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/V1/categories":
+		id, _ := strconv.Atoi(r.URL.Query().Get("rootCategoryId"))
+		respond(m.contents(m.categories[id]))
+	case r.Method == http.MethodPost && r.URL.Path == "/rest/V1/categories":
+		parentID, _ := category["parentId"].(float64)
+		position, _ := category["position"].(float64)
+		c := &fakeCategory{id: m.nextID, parentID: int(parentID), name: category["name"].(string), position: int(position)}
+		m.nextID++
+		m.categories[c.id] = c
+		respond(m.fields(c))
+	case c == nil:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"No such entity."}`)
+	case r.Method == http.MethodGet && len(parts) == 2:
+		respond(m.fields(c))
+	case r.Method == http.MethodPut && len(parts) == 2:
+		position, _ := category["position"].(float64)
+		c.name, c.position = category["name"].(string), int(position)
+		respond(m.fields(c))
+	case r.Method == http.MethodPut && len(parts) == 3 && parts[2] == "move":
+		parentID, _ := body["parentId"].(float64)
+		c.parentID = int(parentID)
+		respond(true)
+	case r.Method == http.MethodDelete && len(parts) == 2:
+		m.delete(c.id)
+		respond(true)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestCategoryTree(t *testing.T) {
+	men := fakeCategory{id: 10, parentID: 2, name: "Men", position: 1}
+	women := fakeCategory{id: 11, parentID: 2, name: "Women", position: 2}
+	shirts := fakeCategory{id: 12, parentID: 10, name: "Shirts", position: 1}
+	sale := fakeCategory{id: 13, parentID: 2, name: "Sale", position: 3}
+	with := func(c fakeCategory, mod func(c *fakeCategory)) fakeCategory {
+		mod(&c)
+		return c
+	}
+
+	type args struct {
+		categories []fakeCategory
+		rootID     string
+		declared   string
+		prune      bool
+		onDelete   string
+		created    bool
+		op         string
+	}
+
+	type want struct {
+		exists   bool
+		upToDate bool
+		err      error
+		writes   []string
+		tree     []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Create": {
+			reason: "The declared categories should be created top down.",
+			args: args{
+				declared: `[{"name":"Men","children":[{"name":"Shirts"},{"name":"Pants"}]},{"name":"Women"}]`,
+				op:       "create",
+			},
+			want: want{
+				writes: []string{"POST /rest/V1/categories", "POST /rest/V1/categories", "POST /rest/V1/categories", "POST /rest/V1/categories"},
+				tree:   []string{"Men", "Men/Shirts", "Men/Pants", "Women"},
+			},
+		},
+		"UpToDate": {
+			reason: "Declared categories in place should be up to date.",
+			args: args{
+				categories: []fakeCategory{men, shirts, women},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"}]},{"name":"Women"}]`,
+				created:    true,
+				op:         "observe",
+			},
+			want: want{
+				exists:   true,
+				upToDate: true,
+				tree:     []string{"Men", "Men/Shirts", "Women"},
+			},
+		},
+		"Reorder": {
+			reason: "Categories declared in another order should be moved to their declared position.",
+			args: args{
+				categories: []fakeCategory{men, women},
+				declared:   `[{"name":"Women"},{"name":"Men"}]`,
+				created:    true,
+				op:         "update",
+			},
+			want: want{
+				writes: []string{"PUT /rest/V1/categories/11", "PUT /rest/V1/categories/10"},
+				tree:   []string{"Women", "Men"},
+			},
+		},
+		"MoveBetweenParentsPruned": {
+			reason: "A pruned tree should move a category declared below another parent.",
+			args: args{
+				categories: []fakeCategory{men, shirts, women},
+				declared:   `[{"name":"Men"},{"name":"Women","children":[{"name":"Shirts"}]}]`,
+				prune:      true,
+				created:    true,
+				op:         "update",
+			},
+			want: want{
+				writes: []string{"PUT /rest/V1/categories/12/move", "PUT /rest/V1/categories/12"},
+				tree:   []string{"Men", "Women", "Women/Shirts"},
+			},
+		},
+		"MoveBetweenParentsNotPruned": {
+			reason: "A tree which is not pruned should create a category declared below another parent and leave the other one.",
+			args: args{
+				categories: []fakeCategory{men, shirts, women},
+				declared:   `[{"name":"Men"},{"name":"Women","children":[{"name":"Shirts"}]}]`,
+				created:    true,
+				op:         "update",
+			},
+			want: want{
+				writes: []string{"POST /rest/V1/categories"},
+				tree:   []string{"Men", "Men/Shirts", "Women", "Women/Shirts"},
+			},
+		},
+		"PruneOn": {
+			reason: "A pruned tree with undeclared categories should delete them.",
+			args: args{
+				categories: []fakeCategory{men, sale, with(shirts, func(c *fakeCategory) { c.parentID = 13 })},
+				declared:   `[{"name":"Men"}]`,
+				prune:      true,
+				created:    true,
+				op:         "update",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/13"},
+				tree:   []string{"Men"},
+			},
+		},
+		"PruneOnNotUpToDate": {
+			reason: "A pruned tree with undeclared categories should not be up to date.",
+			args: args{
+				categories: []fakeCategory{men, sale},
+				declared:   `[{"name":"Men"}]`,
+				prune:      true,
+				created:    true,
+				op:         "observe",
+			},
+			want: want{exists: true, tree: []string{"Men", "Sale"}},
+		},
+		"PruneOff": {
+			reason: "A tree which is not pruned should be up to date with undeclared categories.",
+			args: args{
+				categories: []fakeCategory{men, sale},
+				declared:   `[{"name":"Men"}]`,
+				created:    true,
+				op:         "observe",
+			},
+			want: want{
+				exists:   true,
+				upToDate: true,
+				tree:     []string{"Men", "Sale"},
+			},
+		},
+		"DuplicateSiblings": {
+			reason: "A tree declaring siblings with the same name should be rejected.",
+			args: args{
+				categories: []fakeCategory{men},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"},{"name":"Shirts"}]}]`,
+				op:         "observe",
+			},
+			want: want{
+				err:  errors.New(errDuplicateSiblings + `"Men/Shirts"`),
+				tree: []string{"Men"},
+			},
+		},
+		"DeleteEmpty": {
+			reason: "Declared categories without products or undeclared children should be deleted children first.",
+			args: args{
+				categories: []fakeCategory{men, shirts, women},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"}]}]`,
+				created:    true,
+				op:         "delete",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/12", "DELETE /rest/V1/categories/10"},
+				tree:   []string{"Women"},
+			},
+		},
+		"DeleteBlocked": {
+			reason: "A declared category with products should block deleting the tree by default, before anything is deleted.",
+			args: args{
+				categories: []fakeCategory{men, with(shirts, func(c *fakeCategory) { c.products = 3 }), women},
+				declared:   `[{"name":"Women"},{"name":"Men","children":[{"name":"Shirts"}]}]`,
+				created:    true,
+				op:         "delete",
+			},
+			want: want{
+				err:  errors.Errorf(errNotEmpty, "Men/Shirts", 0, 3),
+				tree: []string{"Men", "Men/Shirts", "Women"},
+			},
+		},
+		"DeleteOrphan": {
+			reason: "A declared category with undeclared children should be left in place when orphaning.",
+			args: args{
+				categories: []fakeCategory{men, shirts, with(sale, func(c *fakeCategory) { c.parentID = 10 })},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"}]}]`,
+				onDelete:   onDeleteOrphan,
+				created:    true,
+				op:         "delete",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/12"},
+				tree:   []string{"Men", "Men/Sale"},
+			},
+		},
+		"DeleteCascade": {
+			reason: "A declared top level category should be deleted along with everything below it when cascading.",
+			args: args{
+				categories: []fakeCategory{with(men, func(c *fakeCategory) { c.products = 2 }), shirts, with(sale, func(c *fakeCategory) { c.parentID = 10 })},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"}]}]`,
+				onDelete:   onDeleteCascade,
+				created:    true,
+				op:         "delete",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/10"},
+			},
+		},
+		"DeleteRootCategory": {
+			reason: "A declared store root category should never be deleted, unlike the categories below it.",
+			args: args{
+				categories: []fakeCategory{men},
+				rootID:     "1",
+				declared:   `[{"name":"Default Category","children":[{"name":"Men"}]}]`,
+				onDelete:   onDeleteCascade,
+				created:    true,
+				op:         "delete",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/10"},
+				tree:   []string{"Default Category"},
+			},
+		},
+		"DeletedEmpty": {
+			reason: "A deleted tree should be gone once its declared categories are deleted.",
+			args: args{
+				categories: []fakeCategory{men, shirts, women},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"}]}]`,
+				created:    true,
+				op:         "deleted",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/12", "DELETE /rest/V1/categories/10"},
+				tree:   []string{"Women"},
+			},
+		},
+		"DeletedOrphan": {
+			reason: "A deleted tree should be gone once the categories left to delete are orphaned.",
+			args: args{
+				categories: []fakeCategory{men, shirts, with(sale, func(c *fakeCategory) { c.parentID = 10 })},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"}]}]`,
+				onDelete:   onDeleteOrphan,
+				created:    true,
+				op:         "deleted",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/12"},
+				tree:   []string{"Men", "Men/Sale"},
+			},
+		},
+		"DeletedRootCategory": {
+			reason: "A deleted tree declaring a store root category should be gone once the categories below it are deleted.",
+			args: args{
+				categories: []fakeCategory{men},
+				rootID:     "1",
+				declared:   `[{"name":"Default Category","children":[{"name":"Men"}]}]`,
+				onDelete:   onDeleteCascade,
+				created:    true,
+				op:         "deleted",
+			},
+			want: want{
+				writes: []string{"DELETE /rest/V1/categories/10"},
+				tree:   []string{"Default Category"},
+			},
+		},
+		"DeletedBlocked": {
+			reason: "A deleted tree whose deletion is blocked should still exist.",
+			args: args{
+				categories: []fakeCategory{men, with(shirts, func(c *fakeCategory) { c.products = 3 })},
+				declared:   `[{"name":"Men","children":[{"name":"Shirts"}]}]`,
+				created:    true,
+				op:         "deleted",
+			},
+			want: want{
+				exists: true,
+				err:    errors.Errorf(errNotEmpty, "Men/Shirts", 0, 3),
+				tree:   []string{"Men", "Men/Shirts"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := newMagentoCategoryTree(tc.args.categories...)
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			rootID := tc.args.rootID
+			if rootID == "" {
+				rootID = "2"
+			}
+			var nodes []categorytreev1alpha1.CategoryTreeNode
+			if err := json.Unmarshal([]byte(tc.args.declared), &nodes); err != nil {
+				t.Fatal(err)
+			}
+			cr := &categorytreev1alpha1.CategoryTree{
+				Spec: categorytreev1alpha1.CategoryTreeSpec{ForProvider: categorytreev1alpha1.CategoryTreeParameters{
+					RootCategoryID: rootID,
+					Categories:     nodes,
+					Prune:          tc.args.prune,
+					OnDelete:       tc.args.onDelete,
+				}},
+			}
+			if tc.args.created {
+				cr.SetAnnotations(map[string]string{id: rootID})
+			}
+
+			e := newCategoryTree(testExternal(ts.URL, "categories", categorytreev1alpha1.CategoryTreeKind))
+			var exists, upToDate bool
+			var err error
+			switch tc.args.op {
+			case "observe":
+				var o managed.ExternalObservation
+				o, err = e.Observe(context.Background(), cr)
+				exists, upToDate = o.ResourceExists, o.ResourceUpToDate
+			case "create":
+				_, err = e.Create(context.Background(), cr)
+			case "update":
+				_, err = e.Update(context.Background(), cr)
+			case "delete":
+				err = e.Delete(context.Background(), cr)
+			case "deleted":
+				// Like the managed reconciler, delete the tree until it is
+				// observed to be gone.
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+				for i := 0; i < 3; i++ {
+					var o managed.ExternalObservation
+					o, err = e.Observe(context.Background(), cr)
+					exists = o.ResourceExists
+					if err != nil || !exists {
+						break
+					}
+					if err = e.Delete(context.Background(), cr); err != nil {
+						break
+					}
+				}
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\n%s: -want error, +got error:\n%s\n", tc.reason, tc.args.op, diff)
+			}
+			if diff := cmp.Diff(tc.want.exists, exists); diff != "" {
+				t.Errorf("\n%s\n%s: -want exists, +got exists:\n%s\n", tc.reason, tc.args.op, diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("\n%s\n%s: -want up to date, +got up to date:\n%s\n", tc.reason, tc.args.op, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\n%s: -want writes, +got writes:\n%s\n", tc.reason, tc.args.op, diff)
+			}
+			root, _ := strconv.Atoi(rootID)
+			if diff := cmp.Diff(tc.want.tree, srv.tree(root, "")); diff != "" {
+				t.Errorf("\n%s\n%s: -want tree, +got tree:\n%s\n", tc.reason, tc.args.op, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	configurableproductchildrenv1alpha1 "github.com/web-seven/provider-magento/apis/configurableproductchildren/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotConfigurableProductChildren = "managed resource is not a ConfigurableProductChildren custom resource"
+	errGetChildren                    = "cannot get configurable product children"
+	errAddChild                       = "cannot add child product "
+	errRemoveChild                    = "cannot remove child product "
+)
+
+// configurableChildren reconcile the children of a configurable product as
+// a set.
+var configurableChildren = &setOps{
+	desired:  configurableChildrenDesired,
+	observed: configurableChildrenObserved,
+	key:      func(en entry) string { return fmt.Sprintf("%v", en["sku"]) },
+	save:     configurableChildrenAdd,
+	remove:   configurableChildrenRemove,
+	record:   configurableChildrenRecord,
+}
+
+// configurableChildrenDesired returns the declared children of a
+// configurable product.
+func configurableChildrenDesired(mg resource.Managed) ([]entry, error) {
+	cr, ok := mg.(*configurableproductchildrenv1alpha1.ConfigurableProductChildren)
+	if !ok {
+		return nil, errors.New(errNotConfigurableProductChildren)
+	}
+	entries := make([]entry, 0, len(cr.Spec.ForProvider.ChildSkus))
+	for _, sku := range cr.Spec.ForProvider.ChildSkus {
+		entries = append(entries, entry{"sku": sku})
+	}
+	return entries, nil
+}
+
+// configurableChildrenObserved returns the children of a configurable
+// product in Magento.
+func configurableChildrenObserved(_ context.Context, e *external, _ resource.Managed) ([]entry, error) {
+	var entries []entry
+	if err := magento.Get(e.service.client, &entries); err != nil {
+		return nil, errors.Wrap(err, errGetChildren)
+	}
+	return entries, nil
+}
+
+// configurableChildrenAdd adds children to a configurable product.
+func configurableChildrenAdd(_ context.Context, e *external, mg resource.Managed, entries []entry) error {
+	cr, ok := mg.(*configurableproductchildrenv1alpha1.ConfigurableProductChildren)
+	if !ok {
+		return errors.New(errNotConfigurableProductChildren)
+	}
+	child := e.endpoint("configurable-products/" + url.PathEscape(cr.Spec.ForProvider.Sku) + "/child")
+	for _, en := range entries {
+		if err := magento.Post(child, map[string]interface{}{"childSku": en["sku"]}, nil); err != nil {
+			return errors.Wrap(err, errAddChild+fmt.Sprintf("%v", en["sku"]))
+		}
+	}
+	return nil
+}
+
+// configurableChildrenRemove removes children from a configurable product.
+func configurableChildrenRemove(_ context.Context, e *external, _ resource.Managed, entries []entry) error {
+	for _, en := range entries {
+		sku := fmt.Sprintf("%v", en["sku"])
+		if err := magento.DeleteResourceByID(e.service.client, sku); err != nil {
+			return errors.Wrap(err, errRemoveChild+sku)
+		}
+	}
+	return nil
+}
+
+// configurableChildrenRecord stores the children of a configurable product
+// in atProvider.
+func configurableChildrenRecord(mg resource.Managed, entries []entry) error {
+	cr, ok := mg.(*configurableproductchildrenv1alpha1.ConfigurableProductChildren)
+	if !ok {
+		return errors.New(errNotConfigurableProductChildren)
+	}
+	skus := make([]string, 0, len(entries))
+	for _, en := range entries {
+		skus = append(skus, fmt.Sprintf("%v", en["sku"]))
+	}
+	sort.Strings(skus)
+	cr.Status.AtProvider.ChildSkus = skus
+	return nil
+}
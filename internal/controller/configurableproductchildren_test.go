@@ -0,0 +1,201 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configurableproductchildrenv1alpha1 "github.com/web-seven/provider-magento/apis/configurableproductchildren/v1alpha1"
+	configurableproductoptionv1alpha1 "github.com/web-seven/provider-magento/apis/configurableproductoption/v1alpha1"
+)
+
+func TestConfigurableProductChildren(t *testing.T) {
+	type want struct {
+		upToDate  bool
+		writes    []string
+		childSkus []string
+	}
+
+	cases := map[string]struct {
+		reason    string
+		childSkus []string
+		want      want
+	}{
+		"UpToDate": {
+			reason:    "A configurable product with the declared children in any order should be up to date.",
+			childSkus: []string{"tshirt-red", "tshirt-blue"},
+			want:      want{upToDate: true, childSkus: []string{"tshirt-blue", "tshirt-red"}},
+		},
+		"Added": {
+			reason:    "A declared child which is missing should be added by its SKU.",
+			childSkus: []string{"tshirt-blue", "tshirt-red", "tshirt-green"},
+			want: want{
+				writes:    []string{`POST /rest/V1/configurable-products/tshirt/child {"childSku":"tshirt-green"}`},
+				childSkus: []string{"tshirt-blue", "tshirt-red"},
+			},
+		},
+		"Removed": {
+			reason:    "A child which is not declared should be removed.",
+			childSkus: []string{"tshirt-blue"},
+			want: want{
+				writes:    []string{`DELETE /rest/V1/configurable-products/tshirt/children/tshirt-red`},
+				childSkus: []string{"tshirt-blue", "tshirt-red"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := &magentoWrites{resources: magentoResources{
+				"/rest/V1/configurable-products/tshirt/children": `[{"id":12,"sku":"tshirt-red","name":"T-Shirt Red","type_id":"simple"},` +
+					`{"id":13,"sku":"tshirt-blue","name":"T-Shirt Blue","type_id":"simple"}]`,
+			}}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			e := testExternal(ts.URL, "configurable-products/tshirt/children", configurableproductchildrenv1alpha1.ConfigurableProductChildrenKind)
+			cr := &configurableproductchildrenv1alpha1.ConfigurableProductChildren{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "tshirt"}},
+				Spec: configurableproductchildrenv1alpha1.ConfigurableProductChildrenSpec{ForProvider: configurableproductchildrenv1alpha1.ConfigurableProductChildrenParameters{
+					Sku:       "tshirt",
+					ChildSkus: tc.childSkus,
+				}},
+			}
+
+			// Like the managed reconciler, only update sets which were
+			// observed not to be up to date.
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !o.ResourceUpToDate {
+				if _, err := e.Update(context.Background(), cr); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.upToDate, o.ResourceUpToDate); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.childSkus, cr.Status.AtProvider.ChildSkus); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want child SKUs, +got child SKUs:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConfigurableProductOption(t *testing.T) {
+	type want struct {
+		exists   bool
+		upToDate bool
+		id       string
+		writes   []string
+	}
+
+	cases := map[string]struct {
+		reason     string
+		externalID string
+		label      string
+		want       want
+	}{
+		"Created": {
+			reason: "A missing option should be created at the options of its product, without the SKU in the body.",
+			label:  "Color",
+			want: want{
+				id:     "5",
+				writes: []string{`POST /rest/V1/configurable-products/tshirt/options {"option":{"attributeId":"93","label":"Color","values":[{"valueIndex":4},{"valueIndex":5}]}}`},
+			},
+		},
+		"UpToDate": {
+			reason:     "An option with the declared fields should be up to date.",
+			externalID: "5",
+			label:      "Color",
+			want:       want{exists: true, upToDate: true, id: "5"},
+		},
+		"LabelChanged": {
+			reason:     "An option whose declared label changed should be updated with its ID in the path and body.",
+			externalID: "5",
+			label:      "Colour",
+			want: want{
+				exists: true,
+				id:     "5",
+				writes: []string{`PUT /rest/V1/configurable-products/tshirt/options/5 {"option":{"attributeId":"93","id":"5","label":"Colour","values":[{"valueIndex":4},{"valueIndex":5}]}}`},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := &magentoWrites{
+				resources: magentoResources{
+					"/rest/V1/configurable-products/tshirt/options/5": `{"id":5,"attribute_id":"93","label":"Color","position":0,"values":[{"value_index":4},{"value_index":5}],"product_id":10}`,
+				},
+				response: `5`,
+			}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			e := testExternal(ts.URL, "configurable-products/tshirt/options", configurableproductoptionv1alpha1.ConfigurableProductOptionKind)
+			cr := &configurableproductoptionv1alpha1.ConfigurableProductOption{
+				Spec: configurableproductoptionv1alpha1.ConfigurableProductOptionSpec{ForProvider: configurableproductoptionv1alpha1.ConfigurableProductOptionParameters{
+					Sku:         "tshirt",
+					AttributeID: "93",
+					Label:       tc.label,
+					Values:      []configurableproductoptionv1alpha1.OptionValue{{ValueIndex: 4}, {ValueIndex: 5}},
+				}},
+			}
+			if tc.externalID != "" {
+				cr.SetAnnotations(map[string]string{id: tc.externalID})
+			}
+
+			// Like the managed reconciler, create missing options and
+			// update options which are not up to date.
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch {
+			case !o.ResourceExists:
+				_, err = e.Create(context.Background(), cr)
+			case !o.ResourceUpToDate:
+				_, err = e.Update(context.Background(), cr)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.exists, o.ResourceExists); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want exists, +got exists:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, o.ResourceUpToDate); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, cr.GetAnnotations()[id]); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want ID, +got ID:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
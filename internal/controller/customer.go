@@ -0,0 +1,179 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	customerv1alpha1 "github.com/web-seven/provider-magento/apis/customer/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotCustomer       = "managed resource is not a Customer custom resource"
+	errGetPasswordSecret = "cannot get customer password secret"
+	errNoPasswordKey     = "customer password secret has no key "
+	errSearchCustomers   = "cannot search customers"
+
+	// Magento hash version of salted SHA-256 password hashes.
+	passwordHashVersion = "1"
+
+	// annotationPasswordVersion keeps the version of the password Secret
+	// applied on creation, as the status set while creating is not kept.
+	annotationPasswordVersion = group + "/password-secret-version"
+)
+
+// customerLookup finds the customer with the email of a Customer within its
+// website.
+func customerLookup(_ context.Context, e *external, mg resource.Managed) (string, error) {
+	cr, ok := mg.(*customerv1alpha1.Customer)
+	if !ok {
+		return "", errors.New(errNotCustomer)
+	}
+	items, err := magento.SearchResources(e.endpoint("customers/search"), map[string]string{
+		"email":      cr.Spec.ForProvider.Email,
+		"website_id": strconv.Itoa(cr.Spec.ForProvider.WebsiteID),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, errSearchCustomers)
+	}
+	if len(items) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%v", items[0]["id"]), nil
+}
+
+// customerPassword returns the password referenced by the Customer along with
+// the resource version of the Secret holding it. Both are empty if the
+// Customer references no password.
+func customerPassword(ctx context.Context, e *external, cr *customerv1alpha1.Customer) (string, string, error) {
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	if ref == nil {
+		return "", "", nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", "", errors.Wrap(err, errGetPasswordSecret)
+	}
+	pw, ok := s.Data[ref.Key]
+	if !ok {
+		return "", "", errors.New(errNoPasswordKey + ref.Key)
+	}
+	return string(pw), s.ResourceVersion, nil
+}
+
+// passwordHash returns the password hashed the way Magento stores it, so it
+// can be set through the customer repository which does not accept plain
+// passwords. Magento upgrades the hash on the next login.
+func passwordHash(password string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	salt := hex.EncodeToString(b)
+	sum := sha256.Sum256([]byte(salt + password))
+	return hex.EncodeToString(sum[:]) + ":" + salt + ":" + passwordHashVersion, nil
+}
+
+// passwordVersion returns the version of the password Secret last applied to
+// the Customer, falling back to the version applied on creation.
+func passwordVersion(cr *customerv1alpha1.Customer) string {
+	if v := cr.Status.AtProvider.PasswordSecretVersion; v != "" {
+		return v
+	}
+	return cr.GetAnnotations()[annotationPasswordVersion]
+}
+
+// customerCreateBody sets the initial password of a new Customer and records
+// the version of the password Secret it was read from.
+func customerCreateBody(ctx context.Context, e *external, mg resource.Managed, body map[string]interface{}) error {
+	cr, ok := mg.(*customerv1alpha1.Customer)
+	if !ok {
+		return errors.New(errNotCustomer)
+	}
+	pw, version, err := customerPassword(ctx, e, cr)
+	if err != nil || pw == "" {
+		return err
+	}
+	body["password"] = pw
+	meta.AddAnnotations(cr, map[string]string{annotationPasswordVersion: version})
+	return nil
+}
+
+// customerUpdateBody sets the password of an existing Customer if the
+// password Secret changed since it was last applied.
+func customerUpdateBody(ctx context.Context, e *external, mg resource.Managed, body map[string]interface{}) error {
+	cr, ok := mg.(*customerv1alpha1.Customer)
+	if !ok {
+		return errors.New(errNotCustomer)
+	}
+	pw, version, err := customerPassword(ctx, e, cr)
+	if err != nil || version == passwordVersion(cr) {
+		return err
+	}
+	if pw == "" {
+		return nil
+	}
+	hash, err := passwordHash(pw)
+	if err != nil {
+		return err
+	}
+	body["passwordHash"] = hash
+	return nil
+}
+
+// customerUpdated records the version of the password Secret applied by a
+// successful update.
+func customerUpdated(ctx context.Context, e *external, mg resource.Managed) error {
+	cr, ok := mg.(*customerv1alpha1.Customer)
+	if !ok {
+		return errors.New(errNotCustomer)
+	}
+	_, version, err := customerPassword(ctx, e, cr)
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider.PasswordSecretVersion = version
+	return nil
+}
+
+// customerObservePassword records the version of the password Secret last
+// applied and reports whether it is the current version.
+func customerObservePassword(ctx context.Context, e *external, mg resource.Managed, _ map[string]interface{}) (bool, managed.ConnectionDetails, error) {
+	cr, ok := mg.(*customerv1alpha1.Customer)
+	if !ok {
+		return false, nil, errors.New(errNotCustomer)
+	}
+	_, version, err := customerPassword(ctx, e, cr)
+	if err != nil {
+		return false, nil, err
+	}
+	cr.Status.AtProvider.PasswordSecretVersion = passwordVersion(cr)
+	return version == cr.Status.AtProvider.PasswordSecretVersion, nil, nil
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// observeFields compares the declared fields with the fields of a Magento
+// response and returns the observed scalars to record in atProvider. Observed
+// values are typed the way they are declared, so quantities modelled as
+// strings stay strings. Magento leaves unset fields out of its responses, so
+// fields missing from the response are only up to date if declared empty.
+// Lists of the unordered fields are compared as sets.
+func observeFields(declared, remote map[string]interface{}, unordered []string) (bool, map[string]interface{}) {
+	isUpToDate := true
+	values := map[string]interface{}{}
+	for field, v := range declared {
+		rv, ok := remote[snakeCase(field)]
+		if !ok {
+			isUpToDate = isUpToDate && isEmpty(v)
+			continue
+		}
+		isUpToDate = isUpToDate && sameField(field, v, rv, unordered)
+		switch v.(type) {
+		case string:
+			values[field] = fmt.Sprintf("%v", rv)
+		case int64:
+			if n, ok := rv.(json.Number); ok {
+				if i, err := n.Int64(); err == nil {
+					values[field] = i
+				}
+			}
+		case bool:
+			if b, ok := rv.(bool); ok {
+				values[field] = b
+			}
+		}
+	}
+	return isUpToDate, values
+}
+
+// sameValue compares a declared value with the value in a Magento response.
+// Scalars are compared numerically if both are numbers, objects by their
+// declared fields and lists element by element.
+func sameValue(declared, remote interface{}) bool {
+	return sameField("", declared, remote, nil)
+}
+
+// sameField compares the declared value of a field with the value in a
+// Magento response like sameValue, except that lists of the unordered fields,
+// at any depth, are compared regardless of the order of their elements, and
+// custom attributes are compared with the remote attributes of their code.
+func sameField(field string, declared, remote interface{}, unordered []string) bool {
+	switch d := declared.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if !sameField(k, v, r[snakeCase(k)], unordered) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if ok && field == "customAttributes" {
+			return sameAttributes(d, r)
+		}
+		if !ok || len(d) != len(r) {
+			return false
+		}
+		if isUnordered(unordered, field) {
+			return sameElements(d, r, unordered)
+		}
+		for i := range d {
+			if !sameField("", d[i], r[i], unordered) {
+				return false
+			}
+		}
+		return true
+	case bool:
+		return d == remoteBool(remote)
+	}
+	ds, rs := fmt.Sprintf("%v", declared), fmt.Sprintf("%v", remote)
+	if ds == rs {
+		return true
+	}
+	df, err := strconv.ParseFloat(ds, 64)
+	if err != nil {
+		return false
+	}
+	rf, err := strconv.ParseFloat(rs, 64)
+	return err == nil && df == rf
+}
+
+// isEmpty returns true if a declared value is empty, false or zero.
+func isEmpty(declared interface{}) bool {
+	switch d := declared.(type) {
+	case map[string]interface{}:
+		return len(d) == 0
+	case []interface{}:
+		return len(d) == 0
+	case bool:
+		return !d
+	}
+	return sameValue(declared, 0) || fmt.Sprintf("%v", declared) == ""
+}
+
+// sameElements returns true if every declared element matches another
+// element of the response, in any order.
+func sameElements(declared, remote []interface{}, unordered []string) bool {
+	matched := make([]bool, len(remote))
+	for _, d := range declared {
+		found := false
+		for i, r := range remote {
+			if !matched[i] && sameField("", d, r, unordered) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// sameAttributes returns true if every declared custom attribute has the
+// declared value. Magento returns more attributes than are declared.
+func sameAttributes(declared, remote []interface{}) bool {
+	values := make(map[string]interface{}, len(remote))
+	for _, a := range remote {
+		if attr, ok := a.(map[string]interface{}); ok {
+			values[fmt.Sprintf("%v", attr["attribute_code"])] = attr["value"]
+		}
+	}
+	for _, a := range declared {
+		attr, ok := a.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		v, ok := values[fmt.Sprintf("%v", attr["attribute_code"])]
+		if !ok || !sameValue(attr["value"], v) {
+			return false
+		}
+	}
+	return true
+}
+
+// isUnordered returns true if the field is one of the unordered fields.
+func isUnordered(unordered []string, field string) bool {
+	for _, f := range unordered {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// observeResource decodes the fields of a Magento response into atProvider
+// of the managed resource.
+func observeResource(mg resource.Managed, remote map[string]interface{}) error {
+	if remote == nil {
+		return nil
+	}
+	return recordObservation(mg, camelCaseKeys(remote).(map[string]interface{}))
+}
+
+// recordObservation decodes the observed values into atProvider of the
+// managed resource. Fields the observation does not declare are ignored, and
+// so are fields whose type does not match, like quantities modelled as
+// strings, which observeFields returns typed as declared.
+func recordObservation(mg resource.Managed, values map[string]interface{}) error {
+	b, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{"atProvider": values},
+	})
+	if err != nil {
+		return err
+	}
+	var typeErr *json.UnmarshalTypeError
+	if err := json.Unmarshal(b, mg); err != nil && !errors.As(err, &typeErr) {
+		return err
+	}
+	return nil
+}
+
+// camelCaseKeys converts the field names of a Magento response, including
+// those of nested objects, to the names used by the API types.
+func camelCaseKeys(v interface{}) interface{} {
+	switch rv := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(rv))
+		for k, e := range rv {
+			m[camelCase(k)] = camelCaseKeys(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(rv))
+		for i, e := range rv {
+			l[i] = camelCaseKeys(e)
+		}
+		return l
+	}
+	return v
+}
+
+// camelCase converts a field name of a Magento response to the name used by
+// the API types, e.g. source_code to sourceCode.
+func camelCase(field string) string {
+	parts := strings.Split(field, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// snakeCase converts a forProvider field name to the name Magento uses in
+// responses, e.g. sourceCode to source_code.
+func snakeCase(field string) string {
+	var b strings.Builder
+	for _, r := range field {
+		if unicode.IsUpper(r) {
+			b.WriteRune('_')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// remoteInt returns an integer field of a Magento response, or 0 if the
+// field is missing or not an integer.
+func remoteInt(remote map[string]interface{}, field string) int {
+	i, _ := strconv.Atoi(fmt.Sprintf("%v", remote[field]))
+	return i
+}
+
+// remoteBool returns a flag of a Magento response, which may be a boolean or
+// a number.
+func remoteBool(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case nil:
+		return false
+	}
+	return fmt.Sprintf("%v", v) == "1"
+}
+
+// lateInitFields fills the unset forProvider fields with the values Magento
+// defaulted them to, so they are told apart from declared values. It returns
+// true if any field was filled.
+func lateInitFields(observed map[string]interface{}, fields []string, remote map[string]interface{}) bool {
+	filled := false
+	for _, field := range fields {
+		if _, found, _ := unstructured.NestedFieldNoCopy(observed, "spec", "forProvider", field); found {
+			continue
+		}
+		v, ok := lateInitValue(remote[snakeCase(field)])
+		if !ok {
+			continue
+		}
+		if err := unstructured.SetNestedField(observed, v, "spec", "forProvider", field); err == nil {
+			filled = true
+		}
+	}
+	return filled
+}
+
+// lateInitAttributes adds the custom attributes Magento defaulted to the
+// declared customAttributes. It returns true if any attribute was added.
+func lateInitAttributes(observed map[string]interface{}, codes []string, remote map[string]interface{}) bool {
+	declared, _, _ := unstructured.NestedSlice(observed, "spec", "forProvider", "customAttributes")
+	has := make(map[string]bool, len(declared))
+	for _, a := range declared {
+		if attr, ok := a.(map[string]interface{}); ok {
+			has[fmt.Sprintf("%v", attr["attribute_code"])] = true
+		}
+	}
+	attrs, _ := remote["custom_attributes"].([]interface{})
+	filled := false
+	for _, code := range codes {
+		if has[code] {
+			continue
+		}
+		for _, a := range attrs {
+			attr, ok := a.(map[string]interface{})
+			if !ok || attr["attribute_code"] != code {
+				continue
+			}
+			if v, ok := attr["value"].(string); ok && v != "" {
+				declared = append(declared, map[string]interface{}{"attribute_code": code, "value": v})
+				filled = true
+			}
+		}
+	}
+	if filled {
+		_ = unstructured.SetNestedSlice(observed, declared, "spec", "forProvider", "customAttributes")
+	}
+	return filled
+}
+
+// lateInitValue converts a value of a Magento response to the value stored in
+// forProvider. Missing and empty values are not late initialized.
+func lateInitValue(v interface{}) (interface{}, bool) {
+	switch rv := v.(type) {
+	case string:
+		return rv, rv != ""
+	case bool:
+		return rv, true
+	case json.Number:
+		if i, err := rv.Int64(); err == nil {
+			return i, true
+		}
+		return rv.String(), true
+	case []interface{}:
+		values := make([]interface{}, 0, len(rv))
+		for _, e := range rv {
+			ev, ok := lateInitValue(e)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, ev)
+		}
+		return values, len(values) > 0
+	}
+	return nil, false
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	inventorystockv1alpha1 "github.com/web-seven/provider-magento/apis/inventorystock/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotInventoryStock = "managed resource is not an InventoryStock custom resource"
+	errListSourceLinks   = "cannot list stock source links"
+	errSaveSourceLinks   = "cannot save stock source links"
+	errDeleteSourceLinks = "cannot delete stock source links"
+
+	stockSourceLinksPath   = "inventory/stock-source-links"
+	stockSourceLinksDelete = "inventory/stock-source-links-delete"
+)
+
+// stockSourceLinks returns the priorities of the sources linked to a stock,
+// by source code.
+func stockSourceLinks(e *external, stockID string) (map[string]int, error) {
+	items, err := magento.SearchResources(e.endpoint(stockSourceLinksPath), map[string]string{
+		"stock_id": stockID,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListSourceLinks)
+	}
+	links := make(map[string]int, len(items))
+	for _, item := range items {
+		code, _ := item["source_code"].(string)
+		priority, _ := item["priority"].(json.Number)
+		p, _ := priority.Int64()
+		links[code] = int(p)
+	}
+	return links, nil
+}
+
+// stockObserveSourceLinks reports whether the sources linked to an
+// InventoryStock match its declared source links. Stocks declaring no source
+// links, or an empty list, leave the links as they are.
+func stockObserveSourceLinks(_ context.Context, e *external, mg resource.Managed, _ map[string]interface{}) (bool, managed.ConnectionDetails, error) {
+	cr, ok := mg.(*inventorystockv1alpha1.InventoryStock)
+	if !ok {
+		return false, nil, errors.New(errNotInventoryStock)
+	}
+	links, err := stockSourceLinks(e, mg.GetAnnotations()[id])
+	if err != nil {
+		return false, nil, err
+	}
+
+	observed := make([]inventorystockv1alpha1.ObservedStockSourceLink, 0, len(links))
+	for code, priority := range links {
+		observed = append(observed, inventorystockv1alpha1.ObservedStockSourceLink{SourceCode: code, Priority: priority})
+	}
+	sort.Slice(observed, func(i, j int) bool {
+		if observed[i].Priority != observed[j].Priority {
+			return observed[i].Priority < observed[j].Priority
+		}
+		return observed[i].SourceCode < observed[j].SourceCode
+	})
+	cr.Status.AtProvider.SourceLinks = observed
+
+	desired := cr.Spec.ForProvider.SourceLinks
+	if len(desired) == 0 {
+		return true, nil, nil
+	}
+	if len(desired) != len(links) {
+		return false, nil, nil
+	}
+	for _, l := range desired {
+		if p, ok := links[l.SourceCode]; !ok || p != l.Priority {
+			return false, nil, nil
+		}
+	}
+	return true, nil, nil
+}
+
+// stockUpdateSourceLinks saves the declared source links of an
+// InventoryStock and removes links to sources no longer declared. Nothing is
+// saved or removed for stocks declaring no source links.
+func stockUpdateSourceLinks(_ context.Context, e *external, mg resource.Managed) error {
+	cr, ok := mg.(*inventorystockv1alpha1.InventoryStock)
+	if !ok {
+		return errors.New(errNotInventoryStock)
+	}
+	desired := cr.Spec.ForProvider.SourceLinks
+	if len(desired) == 0 {
+		return nil
+	}
+	stockID := mg.GetAnnotations()[id]
+	links, err := stockSourceLinks(e, stockID)
+	if err != nil {
+		return err
+	}
+
+	save := make([]map[string]interface{}, 0, len(desired))
+	for _, l := range desired {
+		save = append(save, map[string]interface{}{
+			"stock_id":    stockID,
+			"source_code": l.SourceCode,
+			"priority":    l.Priority,
+		})
+		delete(links, l.SourceCode)
+	}
+	if err := magento.Post(e.endpoint(stockSourceLinksPath), map[string]interface{}{"links": save}, nil); err != nil {
+		return errors.Wrap(err, errSaveSourceLinks)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+	remove := make([]map[string]interface{}, 0, len(links))
+	for code := range links {
+		remove = append(remove, map[string]interface{}{
+			"stock_id":    stockID,
+			"source_code": code,
+		})
+	}
+	return errors.Wrap(magento.Post(e.endpoint(stockSourceLinksDelete), map[string]interface{}{"links": remove}, nil), errDeleteSourceLinks)
+}
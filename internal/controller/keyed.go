@@ -0,0 +1,140 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNoKeyField  = "managed resource has no value for key field "
+	errSearchItems = "cannot search resources by key"
+	errSaveItems   = "cannot save resources"
+	errDeleteItems = "cannot delete resources"
+
+	// keySeparator joins the key field values into the external ID of keyed
+	// kinds.
+	keySeparator = "/"
+)
+
+// keyed returns true if the kind is identified by its key fields rather than
+// an ID assigned by Magento.
+func (c *external) keyed() bool {
+	return len(c.config.keyFields) > 0
+}
+
+// keyedItem returns forProvider of the managed resource as sent to the bulk
+// endpoints, along with the search filters matching its key.
+func (c *external) keyedItem(mg resource.Managed) (map[string]interface{}, map[string]string, error) {
+	observed, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := magento.RequestBody(c.service.client, observed, c.config.omit...)
+	if err != nil {
+		return nil, nil, err
+	}
+	item, _ := body[c.service.client.Key].(map[string]interface{})
+	filters := make(map[string]string, len(c.config.keyFields))
+	for _, field := range c.config.keyFields {
+		v := fmt.Sprintf("%v", item[field])
+		if item[field] == nil || v == "" {
+			return nil, nil, errors.New(errNoKeyField + field)
+		}
+		filters[snakeCase(field)] = v
+	}
+	return item, filters, nil
+}
+
+// keyedExternalID joins the key field values of the item.
+func (c *external) keyedExternalID(item map[string]interface{}) string {
+	values := make([]string, 0, len(c.config.keyFields))
+	for _, field := range c.config.keyFields {
+		values = append(values, fmt.Sprintf("%v", item[field]))
+	}
+	return strings.Join(values, keySeparator)
+}
+
+// observeKeyed finds the resource by its key through search criteria and
+// compares the declared fields with the found resource.
+func (c *external) observeKeyed(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	item, filters, err := c.keyedItem(mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	items, err := magento.SearchResources(c.service.client, filters)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSearchItems)
+	}
+	if len(items) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err := observeResource(mg, items[0]); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveResource)
+	}
+	isUpToDate, values := observeFields(item, items[0], c.config.unordered)
+	if err := recordObservation(mg, values); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveResource)
+	}
+	mg.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  isUpToDate,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// saveKeyed creates or updates the resource through the bulk save endpoint
+// and records its key as external ID.
+func (c *external) saveKeyed(ctx context.Context, mg resource.Managed) error {
+	item, _, err := c.keyedItem(mg)
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{c.service.client.Key: []interface{}{item}}
+	if err := magento.Post(c.service.client, body, nil); err != nil {
+		return errors.Wrap(err, errSaveItems)
+	}
+	meta.AddAnnotations(mg, map[string]string{id: c.keyedExternalID(item)})
+	return nil
+}
+
+// deleteKeyed removes the resource through the bulk delete endpoint.
+func (c *external) deleteKeyed(ctx context.Context, mg resource.Managed) error {
+	item, _, err := c.keyedItem(mg)
+	if err != nil {
+		return err
+	}
+	key := make(map[string]interface{}, len(c.config.keyFields))
+	for _, field := range c.config.keyFields {
+		key[field] = item[field]
+	}
+	body := map[string]interface{}{c.service.client.Key: []interface{}{key}}
+	return errors.Wrap(magento.Post(c.endpoint(c.config.deletePath), body, nil), errDeleteItems)
+}
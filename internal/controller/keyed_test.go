@@ -0,0 +1,142 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	inventorysourceitemv1alpha1 "github.com/web-seven/provider-magento/apis/inventorysourceitem/v1alpha1"
+)
+
+// magentoSearch fakes a Magento search endpoint, responding with the items
+// matching all equality filters of the search criteria.
+type magentoSearch []map[string]interface{}
+
+func (m magentoSearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	items := []map[string]interface{}{}
+	for _, item := range m {
+		match := true
+		for i := 0; ; i++ {
+			filter := fmt.Sprintf("searchCriteria[filterGroups][%d][filters][0]", i)
+			field := q.Get(filter + "[field]")
+			if field == "" {
+				break
+			}
+			match = match && fmt.Sprintf("%v", item[field]) == q.Get(filter+"[value]")
+		}
+		if match {
+			items = append(items, item)
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items, "total_count": len(items)})
+}
+
+func TestObserveKeyed(t *testing.T) {
+	ts := httptest.NewServer(magentoSearch{
+		{"sku": "tshirt-red-m", "source_code": "chisinau", "quantity": 2.5, "status": 1},
+		{"sku": "tshirt-red-m", "source_code": "balti", "quantity": 0, "status": 0},
+	})
+	defer ts.Close()
+
+	sourceItem := func(sourceCode, quantity string, status int) *inventorysourceitemv1alpha1.InventorySourceItem {
+		return &inventorysourceitemv1alpha1.InventorySourceItem{
+			Spec: inventorysourceitemv1alpha1.InventorySourceItemSpec{
+				ForProvider: inventorysourceitemv1alpha1.InventorySourceItemParameters{
+					Sku:        "tshirt-red-m",
+					SourceCode: sourceCode,
+					Quantity:   quantity,
+					Status:     status,
+				},
+			},
+		}
+	}
+
+	type want struct {
+		o          managed.ExternalObservation
+		atProvider inventorysourceitemv1alpha1.InventorySourceItemObservation
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *inventorysourceitemv1alpha1.InventorySourceItem
+		want   want
+	}{
+		"NotFound": {
+			reason: "A source item no search result matches should not exist yet.",
+			mg:     sourceItem("orhei", "1", 1),
+			want:   want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"UpToDate": {
+			reason: "A source item matching its declared fields should be up to date, with the quantity recorded as declared.",
+			mg:     sourceItem("chisinau", "2.5000", 1),
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				atProvider: inventorysourceitemv1alpha1.InventorySourceItemObservation{Quantity: "2.5", Status: 1},
+			},
+		},
+		"QuantityChanged": {
+			reason: "A source item whose declared quantity changed should not be up to date.",
+			mg:     sourceItem("chisinau", "3", 1),
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				atProvider: inventorysourceitemv1alpha1.InventorySourceItemObservation{Quantity: "2.5", Status: 1},
+			},
+		},
+		"StatusChanged": {
+			reason: "A source item whose declared status changed should not be up to date.",
+			mg:     sourceItem("balti", "0", 1),
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				atProvider: inventorysourceitemv1alpha1.InventorySourceItemObservation{Quantity: "0"},
+			},
+		},
+		"NoKey": {
+			reason: "A source item without source code can not be searched for.",
+			mg:     sourceItem("", "1", 1),
+			want:   want{err: errors.New(errNoKeyField + "sourceCode")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := testExternal(ts.URL, "inventory/source-items", inventorysourceitemv1alpha1.InventorySourceItemKind)
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.atProvider, tc.mg.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want atProvider, +got atProvider:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
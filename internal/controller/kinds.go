@@ -0,0 +1,277 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	categoryv1alpha1 "github.com/web-seven/provider-magento/apis/category/v1alpha1"
+	categoryproductlinkv1alpha1 "github.com/web-seven/provider-magento/apis/categoryproductlink/v1alpha1"
+	categorytreev1alpha1 "github.com/web-seven/provider-magento/apis/categorytree/v1alpha1"
+	configurableproductchildrenv1alpha1 "github.com/web-seven/provider-magento/apis/configurableproductchildren/v1alpha1"
+	configurableproductoptionv1alpha1 "github.com/web-seven/provider-magento/apis/configurableproductoption/v1alpha1"
+	couponv1alpha1 "github.com/web-seven/provider-magento/apis/coupon/v1alpha1"
+	customerv1alpha1 "github.com/web-seven/provider-magento/apis/customer/v1alpha1"
+	customergroupv1alpha1 "github.com/web-seven/provider-magento/apis/customergroup/v1alpha1"
+	inventorysourcev1alpha1 "github.com/web-seven/provider-magento/apis/inventorysource/v1alpha1"
+	inventorysourceitemv1alpha1 "github.com/web-seven/provider-magento/apis/inventorysourceitem/v1alpha1"
+	inventorystockv1alpha1 "github.com/web-seven/provider-magento/apis/inventorystock/v1alpha1"
+	productcustomoptionv1alpha1 "github.com/web-seven/provider-magento/apis/productcustomoption/v1alpha1"
+	productlinksv1alpha1 "github.com/web-seven/provider-magento/apis/productlinks/v1alpha1"
+	productmediav1alpha1 "github.com/web-seven/provider-magento/apis/productmedia/v1alpha1"
+	producttierpricesv1alpha1 "github.com/web-seven/provider-magento/apis/producttierprices/v1alpha1"
+	salesrulev1alpha1 "github.com/web-seven/provider-magento/apis/salesrule/v1alpha1"
+	stockitemv1alpha1 "github.com/web-seven/provider-magento/apis/stockitem/v1alpha1"
+	taxclassv1alpha1 "github.com/web-seven/provider-magento/apis/taxclass/v1alpha1"
+	taxratev1alpha1 "github.com/web-seven/provider-magento/apis/taxrate/v1alpha1"
+	taxrulev1alpha1 "github.com/web-seven/provider-magento/apis/taxrule/v1alpha1"
+)
+
+// A bodyFn adds kind specific fields to a create or update request body.
+type bodyFn func(ctx context.Context, e *external, mg resource.Managed, body map[string]interface{}) error
+
+// An observeFn observes kind specific state of an existing resource, which
+// is not part of forProvider, given the fields of the resource in Magento.
+// It reports whether that state is up to date along with the connection
+// details of the resource.
+type observeFn func(ctx context.Context, e *external, mg resource.Managed, remote map[string]interface{}) (bool, managed.ConnectionDetails, error)
+
+// An updatedFn applies and records kind specific state after a successful
+// update.
+type updatedFn func(ctx context.Context, e *external, mg resource.Managed) error
+
+// A deletionFn reports whether the resource is deleted in Magento along with
+// the managed resource, or left in place. An error blocks the deletion.
+type deletionFn func(ctx context.Context, e *external, mg resource.Managed) (bool, error)
+
+// A lookupFn finds the ID of an existing resource by its natural key, like a
+// title, so resources created outside the provider are adopted rather than
+// created again. It returns an empty ID if there is no such resource.
+type lookupFn func(ctx context.Context, e *external, mg resource.Managed) (string, error)
+
+// A kindConfig customises how a managed resource kind maps onto the Magento
+// REST API. The zero value uses the plural of the kind's CRD as endpoint and
+// the lowercase kind as request body key.
+type kindConfig struct {
+	// path of the endpoint relative to the API version.
+	path string
+	// key wrapping forProvider in request bodies.
+	key string
+	// idKey is the field holding the ID in responses.
+	idKey string
+	// writePath is the endpoint of create and update requests relative to
+	// the API version, for kinds written at another endpoint than they are
+	// read from.
+	writePath string
+	// updateInBody sends updates to the endpoint itself with the ID in the
+	// request body rather than in the path.
+	updateInBody bool
+	// idFrom is the forProvider field identifying the resource, for kinds
+	// not identified by an ID assigned by Magento.
+	idFrom string
+	// undeletable kinds can not be deleted through the API and are left in
+	// place when the managed resource is deleted.
+	undeletable bool
+	// omit lists forProvider fields handled by the provider rather than
+	// sent to Magento.
+	omit []string
+	// keyFields are the forProvider fields identifying resources of kinds
+	// without an ID of their own. Keyed kinds are observed through search
+	// criteria and written through bulk endpoints, with key as the body key
+	// of the item list.
+	keyFields []string
+	// deletePath is the bulk endpoint removing resources of keyed kinds.
+	deletePath string
+	// set reconciles kinds declaring a complete set of entries, identified
+	// by the idFrom field.
+	set *setOps
+	// handler reconciles kinds which do not map onto a single Magento
+	// resource, like a tree of categories.
+	handler func(e *external) managed.ExternalClient
+	// compareFields compares all declared fields with the observed resource
+	// rather than just the name.
+	compareFields bool
+	// unordered lists fields, at any depth, whose lists Magento does not
+	// keep in the declared order and are compared as sets.
+	unordered []string
+	// lateInit lists forProvider fields Magento defaults when they are not
+	// declared. Unset fields are filled from the observed resource.
+	lateInit []string
+	// lateInitAttributes lists custom attributes Magento defaults, which are
+	// added to the declared customAttributes the same way.
+	lateInitAttributes []string
+
+	// Hooks of the kind. save replaces the create and update requests, for
+	// kinds written at another endpoint than they are read from.
+	create   bodyFn
+	update   bodyFn
+	save     bodyFn
+	observe  observeFn
+	updated  updatedFn
+	lookup   lookupFn
+	deletion deletionFn
+}
+
+// kindConfigs holds the customisations of managed resource kinds.
+var kindConfigs = map[string]kindConfig{
+	categoryv1alpha1.CategoryKind: {
+		omit:               []string{"afterId", "onDelete"},
+		lateInit:           []string{"availableSortBy", "position"},
+		lateInitAttributes: []string{"display_mode", "url_key"},
+		update:             categoryMove,
+		observe:            categoryObserve,
+		deletion:           categoryDeletion,
+	},
+	customerv1alpha1.CustomerKind: {
+		compareFields: true,
+		unordered:     []string{"addresses"},
+		create:        customerCreateBody,
+		update:        customerUpdateBody,
+		observe:       customerObservePassword,
+		updated:       customerUpdated,
+		lookup:        customerLookup,
+	},
+	customergroupv1alpha1.CustomerGroupKind: {
+		path:          "customerGroups",
+		key:           "group",
+		compareFields: true,
+	},
+	taxclassv1alpha1.TaxClassKind: {
+		path:          "taxClasses",
+		key:           "taxClass",
+		idKey:         "class_id",
+		compareFields: true,
+	},
+	taxratev1alpha1.TaxRateKind: {
+		path:          "taxRates",
+		key:           "taxRate",
+		updateInBody:  true,
+		compareFields: true,
+		unordered:     []string{"titles"},
+	},
+	taxrulev1alpha1.TaxRuleKind: {
+		path:          "taxRules",
+		key:           "rule",
+		updateInBody:  true,
+		compareFields: true,
+		unordered:     []string{"customerTaxClassIds", "productTaxClassIds", "taxRateIds"},
+	},
+	salesrulev1alpha1.SalesRuleKind: {
+		path:          "salesRules",
+		key:           "rule",
+		idKey:         "rule_id",
+		omit:          []string{"couponGeneration"},
+		compareFields: true,
+		unordered:     []string{"websiteIds", "customerGroupIds", "storeLabels", "conditions"},
+		observe:       salesRuleObserveCoupons,
+		updated:       salesRuleGenerateCoupons,
+	},
+	couponv1alpha1.CouponKind: {
+		path:          "coupons",
+		key:           "coupon",
+		idKey:         "coupon_id",
+		compareFields: true,
+	},
+	inventorysourcev1alpha1.InventorySourceKind: {
+		path:          "inventory/sources",
+		key:           "source",
+		idKey:         "source_code",
+		idFrom:        "sourceCode",
+		undeletable:   true,
+		compareFields: true,
+	},
+	inventorystockv1alpha1.InventoryStockKind: {
+		path:          "inventory/stocks",
+		key:           "stock",
+		idKey:         "stock_id",
+		omit:          []string{"sourceLinks"},
+		compareFields: true,
+		unordered:     []string{"salesChannels"},
+		observe:       stockObserveSourceLinks,
+		updated:       stockUpdateSourceLinks,
+	},
+	inventorysourceitemv1alpha1.InventorySourceItemKind: {
+		path:       "inventory/source-items",
+		key:        "sourceItems",
+		keyFields:  []string{"sku", "sourceCode"},
+		deletePath: "inventory/source-items-delete",
+	},
+	stockitemv1alpha1.StockItemKind: {
+		path:          "stockItems",
+		key:           "stockItem",
+		idKey:         "item_id",
+		idFrom:        "sku",
+		undeletable:   true,
+		omit:          []string{"sku"},
+		compareFields: true,
+		save:          stockItemSave,
+	},
+	producttierpricesv1alpha1.ProductTierPricesKind: {
+		path:   tierPricesPath,
+		idFrom: "sku",
+		set:    tierPrices,
+	},
+	productmediav1alpha1.ProductMediaKind: {
+		path:          "products/{sku}/media",
+		key:           "entry",
+		omit:          []string{"sku", "contentFrom", "fileName"},
+		compareFields: true,
+		create:        productMediaCreateBody,
+		update:        productMediaUpdateBody,
+		observe:       productMediaObserveContent,
+		updated:       productMediaUpdated,
+	},
+	categoryproductlinkv1alpha1.CategoryProductLinkKind: {
+		path:   "categories/{categoryId}/products",
+		idFrom: "categoryId",
+		set:    categoryProducts,
+	},
+	productlinksv1alpha1.ProductLinksKind: {
+		path:   "products/{sku}/links/{linkType}",
+		idFrom: "sku",
+		set:    productLinks,
+	},
+	configurableproductoptionv1alpha1.ConfigurableProductOptionKind: {
+		path:          "configurable-products/{sku}/options",
+		key:           "option",
+		omit:          []string{"sku"},
+		compareFields: true,
+		update:        bodyID,
+	},
+	configurableproductchildrenv1alpha1.ConfigurableProductChildrenKind: {
+		path:   "configurable-products/{sku}/children",
+		idFrom: "sku",
+		set:    configurableChildren,
+	},
+	productcustomoptionv1alpha1.ProductCustomOptionKind: {
+		path:          "products/{productSku}/options",
+		key:           "option",
+		idKey:         "option_id",
+		writePath:     "products/options",
+		compareFields: true,
+		update:        bodyID,
+		lookup:        productCustomOptionLookup,
+	},
+	categorytreev1alpha1.CategoryTreeKind: {
+		path:    "categories",
+		key:     "category",
+		handler: newCategoryTree,
+	},
+}
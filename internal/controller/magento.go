@@ -19,19 +19,23 @@ package controller
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	magento "github.com/web-seven/provider-magento/internal/client"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -47,12 +51,31 @@ const (
 	errGetPC        = "cannot get ProviderConfig"
 	errGetCreds     = "cannot get credentials"
 
-	errNewClient = "cannot create new Service"
-	api          = "/rest"
-	id           = "external-id"
-	separator    = "/"
-	group        = "magento.web7.md"
-	version      = "v1alpha1"
+	errNewClient       = "cannot create new Service"
+	errNoCRD           = "cannot find CustomResourceDefinition of kind "
+	errNoPathField     = "managed resource has no value for path field "
+	errObserveResource = "cannot record observed resource"
+
+	errCreate            = "cannot create resource"
+	errCreateRejected    = "Magento rejected the resource, change its spec to try again"
+	errCreateUnconfirmed = "Magento created the resource but did not tell its ID, set the external-id annotation to adopt it"
+	errNoIDInResponse    = "response has no value for "
+	errUpdate            = "cannot update resource"
+	errDelete            = "cannot delete resource"
+	errVerifyDelete      = "cannot verify the resource was deleted"
+	errStillExists       = "resource still exists after it was deleted"
+
+	// Annotations keeping Create from sending a resource again which
+	// Magento rejected at the same generation, or created already.
+	annotationCreateRejected     = group + "/create-rejected"
+	annotationRejectedGeneration = group + "/create-rejected-generation"
+	annotationCreateUnconfirmed  = group + "/create-unconfirmed"
+	api                          = "/rest"
+	apiVersion                   = "V1"
+	id                           = apisv1alpha1.AnnotationKeyExternalID
+	separator                    = "/"
+	group                        = "magento.web7.md"
+	version                      = "v1alpha1"
 )
 
 // MagentoService is a service that can connect to Magento API.
@@ -74,6 +97,7 @@ type external struct {
 	kube client.Client
 	// A 'client' used to connect to the external resource API. In practice this
 	service *MagentoService
+	config  kindConfig
 }
 
 var cachedMagento *MagentoService
@@ -93,11 +117,22 @@ var (
 	}
 )
 
-// isValidGVK returns true if the GroupVersionKind is a valid Magento API resource.
-func isValidGVK(gvk schema.GroupVersionKind) bool {
-	return gvk.Group == group && gvk.Version == version &&
-		!strings.Contains(gvk.Kind, "Options") && !strings.Contains(gvk.Kind, "List") &&
-		!strings.Contains(gvk.Kind, "Event") && !strings.Contains(gvk.Kind, "Config")
+// pathParam matches the {field} placeholders of endpoint paths.
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// isValidGVK returns true if the GroupVersionKind is a valid Magento API resource,
+// that is a managed resource of the Magento API group. Provider configs, lists
+// and the options registered with every group are not.
+func isValidGVK(scheme *runtime.Scheme, gvk schema.GroupVersionKind) bool {
+	if gvk.Group != group || gvk.Version != version {
+		return false
+	}
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		return false
+	}
+	_, ok := obj.(resource.Managed)
+	return ok
 }
 
 // Setup adds a controller that reconciles managed resources.
@@ -108,12 +143,15 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 	scheme := mgr.GetScheme()
+	if err := v1.AddToScheme(scheme); err != nil {
+		return err
+	}
 	gvks := mgr.GetScheme().AllKnownTypes()
 
 	// Filter GroupVersionKind to include resources that are part of the Magento API
 	var filteredGvks []schema.GroupVersionKind
 	for gvk := range gvks {
-		if isValidGVK(gvk) {
+		if isValidGVK(scheme, gvk) {
 			filteredGvks = append(filteredGvks, gvk)
 		}
 	}
@@ -124,16 +162,21 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		if err != nil {
 			return err
 		}
-		r := managed.NewReconciler(mgr,
-			resource.ManagedKind(gvk),
+		opts := []managed.ReconcilerOption{
 			managed.WithExternalConnecter(&connector{
 				kube:                   mgr.GetClient(),
 				usage:                  resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
 				createMagentoServiceFn: newMagentoService}),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithPollInterval(o.PollInterval),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-			managed.WithConnectionPublishers(cps...))
+			managed.WithConnectionPublishers(cps...),
+		}
+		if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+			opts = append(opts, managed.WithManagementPolicies())
+		}
+		r := managed.NewReconciler(mgr, resource.ManagedKind(gvk), opts...)
 
 		err = ctrl.NewControllerManagedBy(mgr).
 			Named(name).
@@ -176,8 +219,78 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
+
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	config := kindConfigs[gvk.Kind]
+	path, err := c.resourcePath(ctx, gvk, config)
+	if err != nil {
+		return nil, err
+	}
+	path, err = expandPath(path, mg)
+	if err != nil {
+		return nil, err
+	}
+	path = strings.Join([]string{api, apiVersion, path}, separator)
+
+	// The service is shared between kinds, so every external gets its own
+	// client bound to the endpoint of its kind.
+	mc := magento.NewClient(svc.client.BaseURL, svc.client.AccessToken)
+	mc.Path = path
+	mc.Key = config.key
+	if mc.Key == "" {
+		mc.Key = strings.ToLower(gvk.Kind)
+	}
+	if config.idKey != "" {
+		mc.IDKey = config.idKey
+	}
 	client := c.kube
-	return &external{service: svc, kube: client}, nil
+	return &external{service: &MagentoService{client: mc}, kube: client, config: config}, nil
+}
+
+// resourcePath returns the api endpoint of the kind relative to the API
+// version. Unless configured otherwise it is the plural of the kind's
+// CustomResourceDefinition.
+func (c *connector) resourcePath(ctx context.Context, gvk schema.GroupVersionKind, config kindConfig) (string, error) {
+	plural := config.path
+	if plural == "" {
+		crds := &v1.CustomResourceDefinitionList{}
+		_ = c.kube.List(ctx, crds)
+		crd := getDesiredCRD(crds, gvk.Group, gvk.Kind)
+		if crd == nil {
+			return "", errors.New(errNoCRD + gvk.Kind)
+		}
+		plural = crd.Spec.Names.Plural
+	}
+	return plural, nil
+}
+
+// expandPath replaces the {field} placeholders of an endpoint path by the
+// forProvider fields of the managed resource, for endpoints nested under
+// another resource like the media of a product.
+func expandPath(path string, mg resource.Managed) (string, error) {
+	if !strings.Contains(path, "{") {
+		return path, nil
+	}
+	observed, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return "", err
+	}
+	for _, m := range pathParam.FindAllStringSubmatch(path, -1) {
+		v, found, _ := unstructured.NestedFieldNoCopy(observed, "spec", "forProvider", m[1])
+		if !found || fmt.Sprintf("%v", v) == "" {
+			return "", errors.New(errNoPathField + m[1])
+		}
+		path = strings.ReplaceAll(path, m[0], url.PathEscape(fmt.Sprintf("%v", v)))
+	}
+	return path, nil
+}
+
+// endpoint returns a client for another api endpoint of the Magento
+// instance, relative to the API version.
+func (c *external) endpoint(path string) *magento.Client {
+	mc := *c.service.client
+	mc.Path = strings.Join([]string{api, apiVersion, path}, separator)
+	return &mc
 }
 
 // getDesiredCRD returns the CustomResourceDefinition that matches the group and kind.
@@ -190,33 +303,129 @@ func getDesiredCRD(crds *v1.CustomResourceDefinitionList, group string, kind str
 	return nil
 }
 
+// externalID returns the Magento ID of the managed resource. Kinds identified
+// by a forProvider field, like a code, fall back to that field so existing
+// resources are observed before being created.
+func (c *external) externalID(mg resource.Managed, observed map[string]interface{}) string {
+	if externalID := mg.GetAnnotations()[id]; externalID != "" || c.config.idFrom == "" {
+		return externalID
+	}
+	v, found, err := unstructured.NestedFieldNoCopy(observed, "spec", "forProvider", c.config.idFrom)
+	if err != nil || !found {
+		return ""
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// createUnconfirmed records that Magento created the resource without
+// telling its ID, so it is not created again.
+func (c *external) createUnconfirmed(mg resource.Managed, err error) error {
+	meta.AddAnnotations(mg, map[string]string{annotationCreateUnconfirmed: err.Error()})
+	return errors.Wrap(err, errCreateUnconfirmed)
+}
+
+// createBlocked returns an error if creating the resource again would fail
+// the way it did before, or duplicate the resource Magento created without
+// telling its ID.
+func createBlocked(mg resource.Managed) error {
+	a := mg.GetAnnotations()
+	if reason := a[annotationCreateUnconfirmed]; reason != "" {
+		return errors.Wrap(errors.New(reason), errCreateUnconfirmed)
+	}
+	if a[annotationRejectedGeneration] == strconv.FormatInt(mg.GetGeneration(), 10) {
+		return errors.Wrap(errors.New(a[annotationCreateRejected]), errCreateRejected)
+	}
+	return nil
+}
+
+// writeClient returns the client for create and update requests.
+func (c *external) writeClient() *magento.Client {
+	if c.config.writePath != "" {
+		return c.endpoint(c.config.writePath)
+	}
+	return c.service.client
+}
+
+// bodyID sets the external ID in update request bodies, for endpoints which
+// expect it in the body in addition to the path.
+func bodyID(_ context.Context, e *external, mg resource.Managed, body map[string]interface{}) error {
+	if resource, ok := body[e.service.client.Key].(map[string]interface{}); ok {
+		resource[e.service.client.IDKey] = mg.GetAnnotations()[id]
+	}
+	return nil
+}
+
 // Observe checks if the external resource exists and if it is up to date with the managed resource.
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	err := v1.AddToScheme(c.kube.Scheme())
+	if c.config.handler != nil {
+		return c.config.handler(c).Observe(ctx, mg)
+	}
+	// Resources Magento can not delete are left in place.
+	if c.config.undeletable && meta.WasDeleted(mg) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// So are resources the kind decides to keep. Errors blocking the
+	// deletion are surfaced by Delete.
+	if c.config.deletion != nil && meta.WasDeleted(mg) {
+		if del, err := c.config.deletion(ctx, c, mg); err == nil && !del {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+	}
+	if c.keyed() {
+		return c.observeKeyed(ctx, mg)
+	}
+	if c.config.set != nil {
+		return c.observeSet(ctx, mg)
+	}
+	// Adopted resources are reported as late initialized, so their ID is
+	// saved rather than looked up again.
+	adopted := false
+	if mg.GetAnnotations()[id] == "" && c.config.lookup != nil {
+		externalID, err := c.config.lookup(ctx, c, mg)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if externalID != "" {
+			meta.AddAnnotations(mg, map[string]string{id: externalID})
+			adopted = true
+		}
+	}
+
+	observed, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
-	crds := &v1.CustomResourceDefinitionList{}
-	_ = c.kube.List(ctx, crds)
-	crd := getDesiredCRD(crds, mg.GetObjectKind().GroupVersionKind().Group, mg.GetObjectKind().GroupVersionKind().Kind)
 
-	c.service.client.Path = strings.Join([]string{api, strings.ToUpper(mg.GetObjectKind().GroupVersionKind().Version), crd.Spec.Names.Plural}, separator)
-	externalID := mg.GetAnnotations()[id]
+	externalID := c.externalID(mg, observed)
+	if externalID == "" {
+		return managed.ExternalObservation{ResourceExists: false}, createBlocked(mg)
+	}
 	desired, err := magento.GetResourceByID(c.service.client, externalID)
+	if magento.IsNotFound(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
 	if err != nil {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, err
 	}
 
-	observed, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
-	if err != nil {
-		return managed.ExternalObservation{}, err
-	}
-
 	if desired != nil {
-		observed["status"].(map[string]interface{})["atProvider"].(map[string]interface{})["id"] = desired.ID
-		observed["status"].(map[string]interface{})["atProvider"].(map[string]interface{})["name"] = desired.Name
+		// Numeric IDs have to be stored as numbers to fit the observation
+		// types, while codes are part of forProvider already.
+		if observedID, err := desired.ID.Int64(); err == nil {
+			if err := unstructured.SetNestedField(observed, observedID, "status", "atProvider", "id"); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errObserveResource)
+			}
+		}
+		if err := unstructured.SetNestedField(observed, desired.Name, "status", "atProvider", "name"); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errObserveResource)
+		}
+	}
+	lateInitialized := false
+	if desired != nil && shouldLateInitialize(mg) {
+		lateInitialized = lateInitFields(observed, c.config.lateInit, desired.Fields)
+		lateInitialized = lateInitAttributes(observed, c.config.lateInitAttributes, desired.Fields) || lateInitialized
 	}
 
 	err = runtime.DefaultUnstructuredConverter.FromUnstructured(observed, mg)
@@ -225,31 +434,119 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	mg.SetConditions(xpv1.Available())
 
+	var remote map[string]interface{}
+	if desired != nil {
+		remote = desired.Fields
+	}
+	if err := observeResource(mg, remote); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObserveResource)
+	}
 	isUpToDate, _ := magento.IsUpToDate(observed, desired)
+	if c.config.compareFields && desired != nil {
+		body, err := magento.RequestBody(c.service.client, observed, c.config.omit...)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		forProvider, _ := body[c.service.client.Key].(map[string]interface{})
+		upToDate, values := observeFields(forProvider, remote, c.config.unordered)
+		isUpToDate = isUpToDate && upToDate
+		if err := recordObservation(mg, values); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errObserveResource)
+		}
+	}
+	connectionDetails := managed.ConnectionDetails{}
+	if c.config.observe != nil {
+		upToDate, cd, err := c.config.observe(ctx, c, mg, remote)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		isUpToDate = isUpToDate && upToDate
+		for k, v := range cd {
+			connectionDetails[k] = v
+		}
+	}
 	return managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  isUpToDate,
-		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate,
+		ResourceLateInitialized: lateInitialized || adopted,
+		ConnectionDetails:       connectionDetails,
 	}, nil
 }
 
+// shouldLateInitialize returns true unless the management policies of the
+// managed resource leave its spec untouched.
+func shouldLateInitialize(mg resource.Managed) bool {
+	policies := mg.GetManagementPolicies()
+	if len(policies) == 0 {
+		return true
+	}
+	for _, p := range policies {
+		if p == xpv1.ManagementActionAll || p == xpv1.ManagementActionLateInitialize {
+			return true
+		}
+	}
+	return false
+}
+
 // Create a new resource at the external API.
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	mg.SetConditions(xpv1.Creating())
+	if c.config.handler != nil {
+		return c.config.handler(c).Create(ctx, mg)
+	}
+	if c.keyed() {
+		return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, c.saveKeyed(ctx, mg)
+	}
+	if c.config.set != nil {
+		return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}}, c.applySet(ctx, mg)
+	}
 
 	observed, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
-	resource, resp, err := magento.CreateResource(c.service.client, observed)
-	mg.SetAnnotations(map[string]string{id: fmt.Sprintf("%v", resource["id"])})
-
-	if resp.StatusCode() != http.StatusOK {
-		return managed.ExternalCreation{}, errors.New(resp.String())
-	}
+	body, err := magento.RequestBody(c.service.client, observed, c.config.omit...)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
+	if c.config.create != nil {
+		if err := c.config.create(ctx, c, mg, body); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+	if c.config.save != nil {
+		if err := c.config.save(ctx, c, mg, body); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		meta.AddAnnotations(mg, map[string]string{id: c.externalID(mg, observed)})
+		return managed.ExternalCreation{
+			ConnectionDetails: managed.ConnectionDetails{},
+		}, nil
+	}
+	resource, err := magento.CreateResource(c.writeClient(), body)
+	switch {
+	case magento.IsInvalid(err):
+		meta.AddAnnotations(mg, map[string]string{
+			annotationCreateRejected:     err.Error(),
+			annotationRejectedGeneration: strconv.FormatInt(mg.GetGeneration(), 10),
+		})
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRejected)
+	case magento.IsUnreadableResponse(err):
+		return managed.ExternalCreation{}, c.createUnconfirmed(mg, err)
+	case err != nil:
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	externalID := c.externalID(mg, observed)
+	if c.config.idFrom == "" {
+		v, ok := resource[c.service.client.IDKey]
+		if !ok || v == nil || fmt.Sprintf("%v", v) == "" {
+			return managed.ExternalCreation{}, c.createUnconfirmed(mg, errors.New(errNoIDInResponse+c.service.client.IDKey))
+		}
+		externalID = fmt.Sprintf("%v", v)
+	}
+	meta.RemoveAnnotations(mg, annotationCreateRejected, annotationRejectedGeneration, annotationCreateUnconfirmed)
+	meta.AddAnnotations(mg, map[string]string{id: externalID})
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
 	}, nil
@@ -257,14 +554,55 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Update the external resource to reflect the managed resource's desired state.
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	observed, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
-	externalID := mg.GetAnnotations()[id]
+	if c.config.handler != nil {
+		return c.config.handler(c).Update(ctx, mg)
+	}
+	if c.keyed() {
+		return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}}, c.saveKeyed(ctx, mg)
+	}
+	if c.config.set != nil {
+		return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{}}, c.applySet(ctx, mg)
+	}
 
-	err := magento.UpdateResourceByID(c.service.client, externalID, observed)
+	observed, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	externalID := c.externalID(mg, observed)
 
+	body, err := magento.RequestBody(c.service.client, observed, c.config.omit...)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
+	if c.config.update != nil {
+		if err := c.config.update(ctx, c, mg, body); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	var updated map[string]interface{}
+	switch {
+	case c.config.save != nil:
+		err = c.config.save(ctx, c, mg, body)
+	case c.config.updateInBody:
+		updated, err = magento.UpdateResource(c.writeClient(), externalID, body)
+	default:
+		updated, err = magento.UpdateResourceByID(c.writeClient(), externalID, body)
+	}
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	// The updated resource is recorded right away rather than on the next
+	// observation.
+	if err := observeResource(mg, updated); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errObserveResource)
+	}
+
+	if c.config.updated != nil {
+		if err := c.config.updated(ctx, c, mg); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -273,12 +611,38 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 // Delete the external resource.
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if c.config.handler != nil {
+		return c.config.handler(c).Delete(ctx, mg)
+	}
 	externalID := mg.GetAnnotations()[id]
 	mg.SetConditions(xpv1.Deleting())
-	err := magento.DeleteResourceByID(c.service.client, externalID)
-	if err != nil {
-		return err
+	if c.config.deletion != nil {
+		del, err := c.config.deletion(ctx, c, mg)
+		if err != nil || !del {
+			return err
+		}
+	}
+	if c.keyed() {
+		return c.deleteKeyed(ctx, mg)
+	}
+	if c.config.set != nil {
+		return c.deleteSet(ctx, mg)
+	}
+	if externalID == "" {
+		return nil
+	}
+	if err := magento.DeleteResourceByID(c.service.client, externalID); err != nil {
+		return errors.Wrap(err, errDelete)
 	}
 
-	return nil
+	// Some deletions are refused without an error, so the finalizer is only
+	// released once the resource is gone.
+	_, err := magento.GetResourceByID(c.service.client, externalID)
+	switch {
+	case magento.IsNotFound(err):
+		return nil
+	case err != nil:
+		return errors.Wrap(err, errVerifyDelete)
+	}
+	return errors.New(errStillExists)
 }
@@ -18,13 +18,44 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/web-seven/provider-magento/apis"
+	categoryv1alpha1 "github.com/web-seven/provider-magento/apis/category/v1alpha1"
+	couponv1alpha1 "github.com/web-seven/provider-magento/apis/coupon/v1alpha1"
+	customerv1alpha1 "github.com/web-seven/provider-magento/apis/customer/v1alpha1"
+	customergroupv1alpha1 "github.com/web-seven/provider-magento/apis/customergroup/v1alpha1"
+	inventorysourcev1alpha1 "github.com/web-seven/provider-magento/apis/inventorysource/v1alpha1"
+	inventorystockv1alpha1 "github.com/web-seven/provider-magento/apis/inventorystock/v1alpha1"
+	productcustomoptionv1alpha1 "github.com/web-seven/provider-magento/apis/productcustomoption/v1alpha1"
+	salesrulev1alpha1 "github.com/web-seven/provider-magento/apis/salesrule/v1alpha1"
+	taxclassv1alpha1 "github.com/web-seven/provider-magento/apis/taxclass/v1alpha1"
+	taxratev1alpha1 "github.com/web-seven/provider-magento/apis/taxrate/v1alpha1"
+	taxrulev1alpha1 "github.com/web-seven/provider-magento/apis/taxrule/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
 )
 
 // Unlike many Kubernetes projects Crossplane does not use third party testing
@@ -35,9 +66,64 @@ import (
 // https://github.com/golang/go/wiki/TestComments
 // https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
 
+// magentoResources fakes Magento responding to GET requests of its paths
+// with the resources, which are JSON objects.
+type magentoResources map[string]string
+
+func (m magentoResources) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, ok := m[r.URL.Path]
+	if r.Method != http.MethodGet || !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"No such entity."}`)
+		return
+	}
+	fmt.Fprint(w, body)
+}
+
+// testExternal returns an external client of the kind bound to the endpoint
+// at path, like Connect does.
+func testExternal(url, path, kind string) *external {
+	config := kindConfigs[kind]
+	mc := magento.NewClient(url, "token")
+	mc.Path = api + separator + apiVersion + separator + path
+	mc.Key = config.key
+	if mc.Key == "" {
+		mc.Key = strings.ToLower(kind)
+	}
+	if config.idKey != "" {
+		mc.IDKey = config.idKey
+	}
+	return &external{service: &MagentoService{client: mc}, config: config}
+}
+
 func TestObserve(t *testing.T) {
+	ts := httptest.NewServer(magentoResources{
+		"/rest/V1/categories/5":     `{"id":5,"parent_id":2,"name":"Remote","is_active":true,"position":3,"level":2}`,
+		"/rest/V1/categories":       `{"id":5,"parent_id":2,"name":"Remote","is_active":true,"position":3,"level":2,"product_count":4,"children_data":[]}`,
+		"/rest/V1/taxClasses/3":     `{"class_id":3,"class_name":"Retail","class_type":"CUSTOMER"}`,
+		"/rest/V1/customerGroups/4": `{"id":4,"code":"Retail","tax_class_id":3}`,
+		"/rest/V1/taxRates/6":       `{"id":6,"code":"DE","tax_country_id":"DE","tax_postcode":"*","rate":19,"titles":[{"store_id":2,"value":"MwSt"},{"store_id":1,"value":"VAT"}]}`,
+		"/rest/V1/customers/search": `{"items":[{"id":8,"email":"jane@example.com","website_id":1}],"total_count":1}`,
+		"/rest/V1/customers/8": `{"id":8,"group_id":1,"email":"jane@example.com","firstname":"Jane","lastname":"Doe","website_id":1,"store_id":1,` +
+			`"addresses":[{"id":2,"customer_id":8,"street":["Side St 2"],"city":"Berlin","postcode":"10115","country_id":"DE"},` +
+			`{"id":1,"customer_id":8,"street":["Main St 1","Floor 2"],"city":"Berlin","postcode":"10115","country_id":"DE","default_billing":true}],` +
+			`"custom_attributes":[{"attribute_code":"loyalty","value":"gold"},{"attribute_code":"newsletter","value":"1"}]}`,
+		"/rest/V1/salesRules/9": `{"rule_id":9,"name":"Summer","website_ids":[2,1],"customer_group_ids":[1,0],"from_date":"2024-06-01","discount_amount":10,` +
+			`"simple_action":"by_percent","coupon_type":"NO_COUPON","condition":{"condition_type":"Magento\\SalesRule\\Model\\Rule\\Condition\\Combine","aggregator_type":"all","value":"1",` +
+			`"conditions":[{"condition_type":"Magento\\SalesRule\\Model\\Rule\\Condition\\Address","operator":">=","attribute_name":"base_subtotal","value":"100"},` +
+			`{"condition_type":"Magento\\SalesRule\\Model\\Rule\\Condition\\Address","operator":"==","attribute_name":"country_id","value":"DE"}]}}`,
+		"/rest/V1/coupons/11":                   `{"coupon_id":11,"rule_id":9,"code":"SUMMER24","usage_limit":100,"usage_per_customer":1,"times_used":3,"is_primary":true,"expiration_date":"2024-08-31","type":0}`,
+		"/rest/V1/inventory/stocks/2":           `{"stock_id":2,"name":"Moldova","extension_attributes":{"sales_channels":[{"type":"website","code":"md"},{"type":"website","code":"base"}]}}`,
+		"/rest/V1/inventory/stock-source-links": `{"items":[],"total_count":0}`,
+		"/rest/V1/inventory/sources/chisinau":   `{"source_code":"chisinau","name":"Chisinau","enabled":true,"description":"Main warehouse","latitude":47.0105,"country_id":"MD","postcode":"2001"}`,
+		"/rest/V1/taxRules/7":                   `{"id":7,"code":"Retail DE","priority":0,"position":0,"customer_tax_class_ids":[3],"product_tax_class_ids":[2,8],"tax_rate_ids":[6,9],"calculate_subtotal":false}`,
+	})
+	defer ts.Close()
+
 	type fields struct {
-		service interface{}
+		path string
+		kind string
+		kube client.Client
 	}
 
 	type args struct {
@@ -50,18 +136,527 @@ func TestObserve(t *testing.T) {
 		err error
 	}
 
+	customer := func(annotations map[string]string, mod func(p *customerv1alpha1.CustomerParameters)) *customerv1alpha1.Customer {
+		p := customerv1alpha1.CustomerParameters{
+			Email:     "jane@example.com",
+			WebsiteID: 1,
+			Firstname: "Jane",
+			Lastname:  "Doe",
+			Addresses: []customerv1alpha1.CustomerAddress{
+				{Street: []string{"Main St 1", "Floor 2"}, City: "Berlin", Postcode: "10115", CountryID: "DE", DefaultBilling: true},
+				{Street: []string{"Side St 2"}, City: "Berlin", Postcode: "10115", CountryID: "DE"},
+			},
+			CustomAttributes: []customerv1alpha1.CustomAttributes{{AttributeCode: "loyalty", Value: "gold"}},
+		}
+		if mod != nil {
+			mod(&p)
+		}
+		return &customerv1alpha1.Customer{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       customerv1alpha1.CustomerSpec{ForProvider: p},
+		}
+	}
+	password := &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+		s := obj.(*corev1.Secret)
+		s.ResourceVersion = "3"
+		s.Data = map[string][]byte{"password": []byte("secret")}
+		return nil
+	})}
+	salesRule := func(mod func(p *salesrulev1alpha1.SalesRuleParameters)) *salesrulev1alpha1.SalesRule {
+		p := salesrulev1alpha1.SalesRuleParameters{
+			Name:             "Summer",
+			WebsiteIDs:       []int{1, 2},
+			CustomerGroupIDs: []string{"0", "1"},
+			FromDate:         "2024-06-01",
+			SimpleAction:     "by_percent",
+			DiscountAmount:   "10.0000",
+			CouponType:       "NO_COUPON",
+			Condition: &salesrulev1alpha1.SalesRuleCondition{
+				ConditionType:  `Magento\SalesRule\Model\Rule\Condition\Combine`,
+				AggregatorType: "all",
+				Value:          "1",
+				Conditions: []salesrulev1alpha1.SalesRuleSubCondition{
+					{ConditionType: `Magento\SalesRule\Model\Rule\Condition\Address`, Operator: "==", AttributeName: "country_id", Value: "DE"},
+					{ConditionType: `Magento\SalesRule\Model\Rule\Condition\Address`, Operator: ">=", AttributeName: "base_subtotal", Value: "100"},
+				},
+			},
+		}
+		if mod != nil {
+			mod(&p)
+		}
+		return &salesrulev1alpha1.SalesRule{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "9"}},
+			Spec:       salesrulev1alpha1.SalesRuleSpec{ForProvider: p},
+		}
+	}
+	coupon := func(mod func(p *couponv1alpha1.CouponParameters)) *couponv1alpha1.Coupon {
+		p := couponv1alpha1.CouponParameters{RuleID: "9", Code: "SUMMER24", UsageLimit: 100, UsagePerCustomer: 1, ExpirationDate: "2024-08-31", IsPrimary: true}
+		if mod != nil {
+			mod(&p)
+		}
+		return &couponv1alpha1.Coupon{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "11"}},
+			Spec:       couponv1alpha1.CouponSpec{ForProvider: p},
+		}
+	}
+	stock := func(channels ...string) *inventorystockv1alpha1.InventoryStock {
+		ext := &inventorystockv1alpha1.StockExtensionAttributes{}
+		for _, c := range channels {
+			ext.SalesChannels = append(ext.SalesChannels, inventorystockv1alpha1.SalesChannel{Type: "website", Code: c})
+		}
+		return &inventorystockv1alpha1.InventoryStock{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "2"}},
+			Spec:       inventorystockv1alpha1.InventoryStockSpec{ForProvider: inventorystockv1alpha1.InventoryStockParameters{Name: "Moldova", ExtensionAttributes: ext}},
+		}
+	}
+	source := func(mod func(p *inventorysourcev1alpha1.InventorySourceParameters)) *inventorysourcev1alpha1.InventorySource {
+		p := inventorysourcev1alpha1.InventorySourceParameters{
+			SourceCode:  "chisinau",
+			Name:        "Chisinau",
+			Enabled:     true,
+			Description: "Main warehouse",
+			Latitude:    "47.0105",
+			CountryID:   "MD",
+			Postcode:    "2001",
+		}
+		if mod != nil {
+			mod(&p)
+		}
+		return &inventorysourcev1alpha1.InventorySource{Spec: inventorysourcev1alpha1.InventorySourceSpec{ForProvider: p}}
+	}
+	withPassword := func(p *customerv1alpha1.CustomerParameters) {
+		p.PasswordSecretRef = &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "jane", Namespace: "default"}, Key: "password"}
+	}
+
 	cases := map[string]struct {
 		reason string
 		fields fields
 		args   args
 		want   want
 	}{
-		// TODO: Add test cases.
+		"NoExternalID": {
+			reason: "A resource without external ID should not exist yet.",
+			fields: fields{path: "categories", kind: categoryv1alpha1.CategoryKind},
+			args: args{
+				ctx: context.Background(),
+				mg:  &categoryv1alpha1.Category{Spec: categoryv1alpha1.CategorySpec{ForProvider: categoryv1alpha1.CategoryParameters{Name: "Example"}}},
+			},
+			want: want{o: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"ResourceWithoutStatus": {
+			reason: "A resource which was never observed should be observed rather than crash the worker.",
+			fields: fields{path: "categories", kind: categoryv1alpha1.CategoryKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &categoryv1alpha1.Category{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "5"}},
+					Spec:       categoryv1alpha1.CategorySpec{ForProvider: categoryv1alpha1.CategoryParameters{Name: "Example", Position: 3}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"TaxClassUpToDate": {
+			reason: "A tax class with the declared fields should be up to date.",
+			fields: fields{path: "taxClasses", kind: taxclassv1alpha1.TaxClassKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &taxclassv1alpha1.TaxClass{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "3"}},
+					Spec:       taxclassv1alpha1.TaxClassSpec{ForProvider: taxclassv1alpha1.TaxClassParameters{ClassName: "Retail", ClassType: "CUSTOMER"}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"TaxClassRenamed": {
+			reason: "A tax class whose declared name changed should not be up to date.",
+			fields: fields{path: "taxClasses", kind: taxclassv1alpha1.TaxClassKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &taxclassv1alpha1.TaxClass{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "3"}},
+					Spec:       taxclassv1alpha1.TaxClassSpec{ForProvider: taxclassv1alpha1.TaxClassParameters{ClassName: "Wholesale", ClassType: "CUSTOMER"}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerGroupRenamed": {
+			reason: "A customer group whose declared code changed should not be up to date.",
+			fields: fields{path: "customerGroups", kind: customergroupv1alpha1.CustomerGroupKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &customergroupv1alpha1.CustomerGroup{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "4"}},
+					Spec:       customergroupv1alpha1.CustomerGroupSpec{ForProvider: customergroupv1alpha1.CustomerGroupParameters{Code: "Wholesale", TaxClassID: "3"}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerGroupTaxClassChanged": {
+			reason: "A customer group whose declared tax class changed should not be up to date.",
+			fields: fields{path: "customerGroups", kind: customergroupv1alpha1.CustomerGroupKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &customergroupv1alpha1.CustomerGroup{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "4"}},
+					Spec:       customergroupv1alpha1.CustomerGroupSpec{ForProvider: customergroupv1alpha1.CustomerGroupParameters{Code: "Retail", TaxClassID: "5"}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"TaxRateUpToDate": {
+			reason: "A tax rate whose titles Magento returns in another order should be up to date.",
+			fields: fields{path: "taxRates", kind: taxratev1alpha1.TaxRateKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &taxratev1alpha1.TaxRate{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "6"}},
+					Spec: taxratev1alpha1.TaxRateSpec{ForProvider: taxratev1alpha1.TaxRateParameters{
+						Code: "DE", TaxCountryID: "DE", TaxPostcode: "*", Rate: "19.00",
+						Titles: []taxratev1alpha1.TaxRateTitle{{StoreID: 1, Value: "VAT"}, {StoreID: 2, Value: "MwSt"}},
+					}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"TaxRateChanged": {
+			reason: "A tax rate whose declared rate changed should not be up to date.",
+			fields: fields{path: "taxRates", kind: taxratev1alpha1.TaxRateKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &taxratev1alpha1.TaxRate{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "6"}},
+					Spec: taxratev1alpha1.TaxRateSpec{ForProvider: taxratev1alpha1.TaxRateParameters{
+						Code: "DE", TaxCountryID: "DE", TaxPostcode: "*", Rate: "7.00",
+					}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"TaxRuleUpToDate": {
+			reason: "A tax rule declaring its IDs in another order than Magento returns them should be up to date.",
+			fields: fields{path: "taxRules", kind: taxrulev1alpha1.TaxRuleKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &taxrulev1alpha1.TaxRule{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "7"}},
+					Spec: taxrulev1alpha1.TaxRuleSpec{ForProvider: taxrulev1alpha1.TaxRuleParameters{
+						Code:                "Retail DE",
+						CustomerTaxClassIDs: []string{"3"},
+						ProductTaxClassIDs:  []string{"8", "2"},
+						TaxRateIDs:          []string{"9", "6"},
+					}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"TaxRuleRateRemoved": {
+			reason: "A tax rule which no longer declares one of its tax rates should not be up to date.",
+			fields: fields{path: "taxRules", kind: taxrulev1alpha1.TaxRuleKind},
+			args: args{
+				ctx: context.Background(),
+				mg: &taxrulev1alpha1.TaxRule{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "7"}},
+					Spec: taxrulev1alpha1.TaxRuleSpec{ForProvider: taxrulev1alpha1.TaxRuleParameters{
+						Code:                "Retail DE",
+						CustomerTaxClassIDs: []string{"3"},
+						ProductTaxClassIDs:  []string{"8", "2"},
+						TaxRateIDs:          []string{"6"},
+					}},
+				},
+			},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerLookedUp": {
+			reason: "A customer without external ID should be found by email and website and adopted, regardless of the order of its addresses and of undeclared attributes.",
+			fields: fields{path: "customers", kind: customerv1alpha1.CustomerKind},
+			args:   args{ctx: context.Background(), mg: customer(nil, nil)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        true,
+				ResourceLateInitialized: true,
+				ConnectionDetails:       managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerRenamed": {
+			reason: "A customer whose declared first name changed should not be up to date.",
+			fields: fields{path: "customers", kind: customerv1alpha1.CustomerKind},
+			args: args{ctx: context.Background(), mg: customer(map[string]string{id: "8"}, func(p *customerv1alpha1.CustomerParameters) {
+				p.Firstname = "Janet"
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerGroupChanged": {
+			reason: "A customer whose declared group changed should not be up to date.",
+			fields: fields{path: "customers", kind: customerv1alpha1.CustomerKind},
+			args: args{ctx: context.Background(), mg: customer(map[string]string{id: "8"}, func(p *customerv1alpha1.CustomerParameters) {
+				p.GroupID = 2
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerStreetChanged": {
+			reason: "A customer whose declared street lines changed order should not be up to date.",
+			fields: fields{path: "customers", kind: customerv1alpha1.CustomerKind},
+			args: args{ctx: context.Background(), mg: customer(map[string]string{id: "8"}, func(p *customerv1alpha1.CustomerParameters) {
+				p.Addresses[0].Street = []string{"Floor 2", "Main St 1"}
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerAttributeChanged": {
+			reason: "A customer whose declared custom attribute changed should not be up to date.",
+			fields: fields{path: "customers", kind: customerv1alpha1.CustomerKind},
+			args: args{ctx: context.Background(), mg: customer(map[string]string{id: "8"}, func(p *customerv1alpha1.CustomerParameters) {
+				p.CustomAttributes[0].Value = "silver"
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerPasswordSetOnCreation": {
+			reason: "A customer whose password was set on creation from the current Secret should be up to date.",
+			fields: fields{path: "customers", kind: customerv1alpha1.CustomerKind, kube: password},
+			args:   args{ctx: context.Background(), mg: customer(map[string]string{id: "8", annotationPasswordVersion: "3"}, withPassword)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CustomerPasswordChanged": {
+			reason: "A customer whose password Secret changed since its password was set should not be up to date.",
+			fields: fields{path: "customers", kind: customerv1alpha1.CustomerKind, kube: password},
+			args:   args{ctx: context.Background(), mg: customer(map[string]string{id: "8", annotationPasswordVersion: "2"}, withPassword)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"SalesRuleUpToDate": {
+			reason: "A sales rule declaring its IDs and conditions in another order than Magento returns them should be up to date.",
+			fields: fields{path: "salesRules", kind: salesrulev1alpha1.SalesRuleKind},
+			args:   args{ctx: context.Background(), mg: salesRule(nil)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"SalesRuleDiscountChanged": {
+			reason: "A sales rule whose declared discount changed should not be up to date.",
+			fields: fields{path: "salesRules", kind: salesrulev1alpha1.SalesRuleKind},
+			args: args{ctx: context.Background(), mg: salesRule(func(p *salesrulev1alpha1.SalesRuleParameters) {
+				p.DiscountAmount = "15"
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"SalesRuleToDateAdded": {
+			reason: "A sales rule declaring an end date Magento does not have yet should not be up to date.",
+			fields: fields{path: "salesRules", kind: salesrulev1alpha1.SalesRuleKind},
+			args: args{ctx: context.Background(), mg: salesRule(func(p *salesrulev1alpha1.SalesRuleParameters) {
+				p.ToDate = "2024-08-31"
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"SalesRuleConditionChanged": {
+			reason: "A sales rule whose declared condition changed should not be up to date.",
+			fields: fields{path: "salesRules", kind: salesrulev1alpha1.SalesRuleKind},
+			args: args{ctx: context.Background(), mg: salesRule(func(p *salesrulev1alpha1.SalesRuleParameters) {
+				p.Condition.Conditions[1].Value = "200"
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"SalesRuleCustomerGroupsChanged": {
+			reason: "A sales rule whose declared customer groups changed should not be up to date.",
+			fields: fields{path: "salesRules", kind: salesrulev1alpha1.SalesRuleKind},
+			args: args{ctx: context.Background(), mg: salesRule(func(p *salesrulev1alpha1.SalesRuleParameters) {
+				p.CustomerGroupIDs = []string{"1", "2"}
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CouponUpToDate": {
+			reason: "A coupon matching its declared fields should be up to date.",
+			fields: fields{path: "coupons", kind: couponv1alpha1.CouponKind},
+			args:   args{ctx: context.Background(), mg: coupon(nil)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CouponUsageLimitChanged": {
+			reason: "A coupon whose declared usage limit changed should not be up to date.",
+			fields: fields{path: "coupons", kind: couponv1alpha1.CouponKind},
+			args: args{ctx: context.Background(), mg: coupon(func(p *couponv1alpha1.CouponParameters) {
+				p.UsageLimit = 200
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CouponUsageLimitRemoved": {
+			reason: "A coupon which no longer declares a usage limit should not be up to date.",
+			fields: fields{path: "coupons", kind: couponv1alpha1.CouponKind},
+			args: args{ctx: context.Background(), mg: coupon(func(p *couponv1alpha1.CouponParameters) {
+				p.UsageLimit = 0
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CouponExpirationDateChanged": {
+			reason: "A coupon whose declared expiration date changed should not be up to date.",
+			fields: fields{path: "coupons", kind: couponv1alpha1.CouponKind},
+			args: args{ctx: context.Background(), mg: coupon(func(p *couponv1alpha1.CouponParameters) {
+				p.ExpirationDate = "2024-09-30"
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"CouponNoLongerPrimary": {
+			reason: "A coupon which is no longer declared primary should not be up to date.",
+			fields: fields{path: "coupons", kind: couponv1alpha1.CouponKind},
+			args: args{ctx: context.Background(), mg: coupon(func(p *couponv1alpha1.CouponParameters) {
+				p.IsPrimary = false
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"InventoryStockUpToDate": {
+			reason: "A stock declaring its sales channels in another order than Magento returns them should be up to date.",
+			fields: fields{path: "inventory/stocks", kind: inventorystockv1alpha1.InventoryStockKind},
+			args:   args{ctx: context.Background(), mg: stock("base", "md")},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"InventoryStockSalesChannelsChanged": {
+			reason: "A stock whose declared sales channels changed should not be up to date.",
+			fields: fields{path: "inventory/stocks", kind: inventorystockv1alpha1.InventoryStockKind},
+			args:   args{ctx: context.Background(), mg: stock("base")},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"InventorySourceUpToDate": {
+			reason: "A source matching its declared fields should be up to date.",
+			fields: fields{path: "inventory/sources", kind: inventorysourcev1alpha1.InventorySourceKind},
+			args:   args{ctx: context.Background(), mg: source(nil)},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"InventorySourceDisabled": {
+			reason: "A source whose declared enabled flag changed should not be up to date.",
+			fields: fields{path: "inventory/sources", kind: inventorysourcev1alpha1.InventorySourceKind},
+			args: args{ctx: context.Background(), mg: source(func(p *inventorysourcev1alpha1.InventorySourceParameters) {
+				p.Enabled = false
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
+		"InventorySourceDescriptionChanged": {
+			reason: "A source whose declared description changed should not be up to date.",
+			fields: fields{path: "inventory/sources", kind: inventorysourcev1alpha1.InventorySourceKind},
+			args: args{ctx: context.Background(), mg: source(func(p *inventorysourcev1alpha1.InventorySourceParameters) {
+				p.Description = "Backup warehouse"
+			})},
+			want: want{o: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{},
+			}},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{service: tc.fields.service.(*MagentoService)}
+			e := testExternal(ts.URL, tc.fields.path, tc.fields.kind)
+			e.kube = tc.fields.kube
 			got, err := e.Observe(tc.args.ctx, tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
@@ -72,3 +667,618 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		status int
+		body   string
+	}
+
+	type want struct {
+		annotations map[string]string
+		err         error
+		observeErr  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Created": {
+			reason: "The ID of a created resource should be recorded.",
+			args:   args{status: http.StatusOK, body: `{"id":6,"name":"Example"}`},
+			want:   want{annotations: map[string]string{"keep": "true", id: "6"}},
+		},
+		"Rejected": {
+			reason: "A resource Magento rejects should not be created again until its spec changes.",
+			args:   args{status: http.StatusBadRequest, body: `{"message":"The \"%1\" value is invalid.","parameters":["name"]}`},
+			want: want{
+				annotations: map[string]string{"keep": "true", annotationCreateRejected: `The "name" value is invalid.`, annotationRejectedGeneration: "1"},
+				err:         errors.Wrap(errors.New(`The "name" value is invalid.`), errCreateRejected),
+				observeErr:  errors.Wrap(errors.New(`The "name" value is invalid.`), errCreateRejected),
+			},
+		},
+		"Failed": {
+			reason: "A failed create should be retried.",
+			args:   args{status: http.StatusInternalServerError, body: `{"message":"Internal Error."}`},
+			want: want{
+				annotations: map[string]string{"keep": "true"},
+				err:         errors.Wrap(errors.New("Internal Error."), errCreate),
+			},
+		},
+		"Unreadable": {
+			reason: "A resource Magento created without telling its ID should not be created again.",
+			args:   args{status: http.StatusOK, body: `<html>`},
+			want: want{
+				annotations: map[string]string{"keep": "true", annotationCreateUnconfirmed: "cannot read response: invalid character '<' looking for beginning of value"},
+				err:         errors.Wrap(errors.New("cannot read response: invalid character '<' looking for beginning of value"), errCreateUnconfirmed),
+				observeErr:  errors.Wrap(errors.New("cannot read response: invalid character '<' looking for beginning of value"), errCreateUnconfirmed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.args.status)
+				fmt.Fprint(w, tc.args.body)
+			}))
+			defer ts.Close()
+			mc := magento.NewClient(ts.URL, "token")
+			mc.Path = api + separator + apiVersion + separator + "categories"
+			mc.Key = "category"
+			e := external{service: &MagentoService{client: mc}}
+
+			cr := &categoryv1alpha1.Category{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1, Annotations: map[string]string{"keep": "true"}},
+				Spec:       categoryv1alpha1.CategorySpec{ForProvider: categoryv1alpha1.CategoryParameters{Name: "Example"}},
+			}
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.annotations, cr.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want annotations, +got annotations:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.observeErr == nil {
+				return
+			}
+			_, err = e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.observeErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCustomerCreateBody(t *testing.T) {
+	type want struct {
+		body        map[string]interface{}
+		annotations map[string]string
+	}
+
+	cases := map[string]struct {
+		reason string
+		ref    *xpv1.SecretKeySelector
+		want   want
+	}{
+		"Password": {
+			reason: "A new customer should get the password of its Secret and record the version applied.",
+			ref:    &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "jane", Namespace: "default"}, Key: "password"},
+			want: want{
+				body:        map[string]interface{}{"password": "secret"},
+				annotations: map[string]string{annotationPasswordVersion: "3"},
+			},
+		},
+		"NoPassword": {
+			reason: "A new customer without password Secret should be created without password.",
+			want:   want{body: map[string]interface{}{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				s := obj.(*corev1.Secret)
+				s.ResourceVersion = "3"
+				s.Data = map[string][]byte{"password": []byte("secret")}
+				return nil
+			})}
+			cr := &customerv1alpha1.Customer{Spec: customerv1alpha1.CustomerSpec{ForProvider: customerv1alpha1.CustomerParameters{PasswordSecretRef: tc.ref}}}
+			body := map[string]interface{}{}
+			if err := customerCreateBody(context.Background(), &external{kube: kube}, cr, body); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.body, body); diff != "" {
+				t.Errorf("\n%s\ncustomerCreateBody(...): -want body, +got body:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.annotations, cr.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\ncustomerCreateBody(...): -want annotations, +got annotations:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	afterID := 4
+
+	type args struct {
+		status     int
+		body       string
+		parentID   string
+		afterID    *int
+		atProvider categoryv1alpha1.CategoryObservation
+	}
+
+	type want struct {
+		atProvider categoryv1alpha1.CategoryObservation
+		err        error
+		writes     []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Updated": {
+			reason: "The updated resource should be recorded in atProvider.",
+			args:   args{status: http.StatusOK, body: `{"id":5,"parent_id":2,"name":"Example","is_active":true,"position":1,"level":2,"path":"1/2/5"}`},
+			want: want{
+				atProvider: categoryv1alpha1.CategoryObservation{ID: 5, ParentID: 2, Name: "Example", IsActive: true, Position: 1, Level: 2, Path: "1/2/5"},
+				writes:     []string{"PUT /rest/V1/categories/5"},
+			},
+		},
+		"Rejected": {
+			reason: "An update Magento rejects should fail with the message of Magento.",
+			args:   args{status: http.StatusBadRequest, body: `{"message":"Could not save category: %message","parameters":{"message":"URL key for specified store already exists."}}`},
+			want: want{
+				err:    errors.Wrap(errors.New("Could not save category: URL key for specified store already exists."), errUpdate),
+				writes: []string{"PUT /rest/V1/categories/5"},
+			},
+		},
+		"Flag": {
+			reason: "An update Magento responds to with a flag rather than the resource should succeed.",
+			args:   args{status: http.StatusOK, body: `true`},
+			want:   want{writes: []string{"PUT /rest/V1/categories/5"}},
+		},
+		"NoContent": {
+			reason: "An update Magento responds to with an empty body should succeed.",
+			args:   args{status: http.StatusOK},
+			want:   want{writes: []string{"PUT /rest/V1/categories/5"}},
+		},
+		"Unreadable": {
+			reason: "An update Magento responds to with a body which is not JSON should fail.",
+			args:   args{status: http.StatusOK, body: `<html>`},
+			want: want{
+				err:    errors.Wrap(fmt.Errorf("%w: %v", magento.ErrUnreadableResponse, "invalid character '<' looking for beginning of value"), errUpdate),
+				writes: []string{"PUT /rest/V1/categories/5"},
+			},
+		},
+		"Moved": {
+			reason: "A category whose declared parent changed should be moved after the declared sibling before it is updated, and its new place recorded.",
+			args: args{
+				status:     http.StatusOK,
+				body:       `{"id":5,"parent_id":3,"name":"Example","is_active":true,"position":2,"level":3,"path":"1/2/3/5"}`,
+				parentID:   "3",
+				afterID:    &afterID,
+				atProvider: categoryv1alpha1.CategoryObservation{ID: 5, ParentID: 2, Name: "Example", Level: 2, Path: "1/2/5"},
+			},
+			want: want{
+				atProvider: categoryv1alpha1.CategoryObservation{ID: 5, ParentID: 3, Name: "Example", IsActive: true, Position: 2, Level: 3, Path: "1/2/3/5"},
+				writes:     []string{`PUT /rest/V1/categories/5/move {"afterId":4,"parentId":3}`, "PUT /rest/V1/categories/5"},
+			},
+		},
+		"NotMoved": {
+			reason: "A category whose declared parent did not change should not be moved.",
+			args: args{
+				status:     http.StatusOK,
+				body:       `{"id":5,"parent_id":2,"name":"Example","is_active":true,"level":2,"path":"1/2/5"}`,
+				parentID:   "2",
+				afterID:    &afterID,
+				atProvider: categoryv1alpha1.CategoryObservation{ID: 5, ParentID: 2, Name: "Example", Level: 2, Path: "1/2/5"},
+			},
+			want: want{
+				atProvider: categoryv1alpha1.CategoryObservation{ID: 5, ParentID: 2, Name: "Example", IsActive: true, Level: 2, Path: "1/2/5"},
+				writes:     []string{"PUT /rest/V1/categories/5"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var writes []string
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut || r.Header.Get("Content-Type") != "application/json" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if r.URL.Path == "/rest/V1/categories/5/move" {
+					body, _ := io.ReadAll(r.Body)
+					writes = append(writes, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(body)))
+					fmt.Fprint(w, `true`)
+					return
+				}
+				writes = append(writes, r.Method+" "+r.URL.Path)
+				w.WriteHeader(tc.args.status)
+				fmt.Fprint(w, tc.args.body)
+			}))
+			defer ts.Close()
+			e := testExternal(ts.URL, "categories", categoryv1alpha1.CategoryKind)
+
+			cr := &categoryv1alpha1.Category{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "5"}},
+				Spec: categoryv1alpha1.CategorySpec{ForProvider: categoryv1alpha1.CategoryParameters{
+					Name:     "Example",
+					ParentID: tc.args.parentID,
+					AfterID:  tc.args.afterID,
+				}},
+				Status: categoryv1alpha1.CategoryStatus{AtProvider: tc.args.atProvider},
+			}
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.atProvider, cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want atProvider, +got atProvider:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, writes); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		deleteStatus int
+		deleteBody   string
+		getStatus    int
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   error
+	}{
+		"Deleted": {
+			reason: "A resource which is gone after its deletion should be deleted.",
+			args:   args{deleteStatus: http.StatusOK, deleteBody: `true`, getStatus: http.StatusNotFound},
+		},
+		"AlreadyDeleted": {
+			reason: "A resource which does not exist should be deleted already.",
+			args:   args{deleteStatus: http.StatusNotFound, deleteBody: `{"message":"No such entity."}`, getStatus: http.StatusNotFound},
+		},
+		"Forbidden": {
+			reason: "A deletion Magento refuses should fail with the message of Magento.",
+			args:   args{deleteStatus: http.StatusForbidden, deleteBody: `{"message":"The consumer isn't authorized to access %resources.","parameters":{"resources":"Magento_Catalog::categories"}}`},
+			want:   errors.Wrap(errors.New("The consumer isn't authorized to access Magento_Catalog::categories."), errDelete),
+		},
+		"StillExists": {
+			reason: "A resource which still exists after its deletion should not be deleted.",
+			args:   args{deleteStatus: http.StatusOK, deleteBody: `true`, getStatus: http.StatusOK},
+			want:   errors.New(errStillExists),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(tc.args.deleteStatus)
+					fmt.Fprint(w, tc.args.deleteBody)
+					return
+				}
+				w.WriteHeader(tc.args.getStatus)
+				fmt.Fprint(w, `{"id":5,"name":"Example"}`)
+			}))
+			defer ts.Close()
+			mc := magento.NewClient(ts.URL, "token")
+			mc.Path = api + separator + apiVersion + separator + "categories"
+			e := external{service: &MagentoService{client: mc}}
+
+			cr := &categoryv1alpha1.Category{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "5"}}}
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// magentoCategories fakes the category endpoints of Magento with category 5
+// existing under another name than declared, and records write requests.
+type magentoCategories struct {
+	mu     sync.Mutex
+	writes []string
+}
+
+func (m *magentoCategories) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		m.mu.Lock()
+		m.writes = append(m.writes, r.Method+" "+r.URL.Path)
+		m.mu.Unlock()
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/V1/categories/5":
+		fmt.Fprint(w, `{"id":5,"parent_id":2,"name":"Remote","is_active":true,"position":3,"level":2}`)
+	case r.Method == http.MethodGet && r.URL.Path == "/rest/V1/categories":
+		fmt.Fprint(w, `{"id":5,"level":2,"product_count":0,"children_data":[]}`)
+	case r.Method == http.MethodPost && r.URL.Path == "/rest/V1/categories":
+		fmt.Fprint(w, `{"id":6,"name":"Example"}`)
+	case r.Method == http.MethodPut && r.URL.Path == "/rest/V1/categories/5":
+		fmt.Fprint(w, `{"id":5,"name":"Example"}`)
+	case r.Method == http.MethodDelete && r.URL.Path == "/rest/V1/categories/5":
+		fmt.Fprint(w, `true`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"No such entity."}`)
+	}
+}
+
+func TestManagementPolicies(t *testing.T) {
+	all := xpv1.ManagementPolicies{xpv1.ManagementActionAll}
+	observeOnly := xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+	createOnly := xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate}
+	noDelete := xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate, xpv1.ManagementActionLateInitialize}
+	noLateInit := xpv1.ManagementPolicies{xpv1.ManagementActionObserve, xpv1.ManagementActionCreate, xpv1.ManagementActionUpdate, xpv1.ManagementActionDelete}
+
+	type args struct {
+		policies   xpv1.ManagementPolicies
+		externalID string
+		deleted    bool
+	}
+
+	type want struct {
+		writes          []string
+		lateInitialized bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"AllCreates": {
+			reason: "A missing category should be created.",
+			args:   args{policies: all},
+			want:   want{writes: []string{"POST /rest/V1/categories"}},
+		},
+		"AllUpdates": {
+			reason: "An existing category should be late initialized and updated.",
+			args:   args{policies: all, externalID: "5"},
+			want:   want{writes: []string{"PUT /rest/V1/categories/5"}, lateInitialized: true},
+		},
+		"AllDeletes": {
+			reason: "A deleted category should be deleted in Magento.",
+			args:   args{policies: all, externalID: "5", deleted: true},
+			want:   want{writes: []string{"DELETE /rest/V1/categories/5"}},
+		},
+		"ObserveOnlyImports": {
+			reason: "An observe only category should be observed without writing to Magento or its spec.",
+			args:   args{policies: observeOnly, externalID: "5"},
+			want:   want{},
+		},
+		"ObserveOnlyDoesNotCreate": {
+			reason: "A missing observe only category should not be created.",
+			args:   args{policies: observeOnly},
+			want:   want{},
+		},
+		"ObserveOnlyDoesNotDelete": {
+			reason: "A deleted observe only category should be left in Magento.",
+			args:   args{policies: observeOnly, externalID: "5", deleted: true},
+			want:   want{},
+		},
+		"CreateOnlyCreates": {
+			reason: "A missing create only category should be created.",
+			args:   args{policies: createOnly},
+			want:   want{writes: []string{"POST /rest/V1/categories"}},
+		},
+		"CreateOnlyDoesNotUpdate": {
+			reason: "An existing create only category should neither be updated nor late initialized.",
+			args:   args{policies: createOnly, externalID: "5"},
+			want:   want{},
+		},
+		"NoDeleteUpdates": {
+			reason: "A category without the Delete policy should still be late initialized and updated.",
+			args:   args{policies: noDelete, externalID: "5"},
+			want:   want{writes: []string{"PUT /rest/V1/categories/5"}, lateInitialized: true},
+		},
+		"NoDeleteDoesNotDelete": {
+			reason: "A deleted category without the Delete policy should be left in Magento.",
+			args:   args{policies: noDelete, externalID: "5", deleted: true},
+			want:   want{},
+		},
+		"NoLateInitializeUpdates": {
+			reason: "A category without the LateInitialize policy should be updated without late initializing its spec.",
+			args:   args{policies: noLateInit, externalID: "5"},
+			want:   want{writes: []string{"PUT /rest/V1/categories/5"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := &magentoCategories{}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			cr := &categoryv1alpha1.Category{
+				ObjectMeta: metav1.ObjectMeta{Name: "example"},
+				Spec: categoryv1alpha1.CategorySpec{
+					ResourceSpec: xpv1.ResourceSpec{
+						ManagementPolicies: tc.args.policies,
+						DeletionPolicy:     xpv1.DeletionDelete,
+					},
+					ForProvider: categoryv1alpha1.CategoryParameters{Name: "Example", OnDelete: "Block"},
+				},
+			}
+			if tc.args.externalID != "" {
+				cr.SetAnnotations(map[string]string{id: tc.args.externalID})
+			}
+			if tc.args.deleted {
+				now := metav1.Now()
+				cr.SetDeletionTimestamp(&now)
+			}
+
+			lateInitialized := false
+			kube := test.NewMockClient()
+			kube.MockGet = test.NewMockGetFn(nil, func(obj client.Object) error {
+				cr.DeepCopyInto(obj.(*categoryv1alpha1.Category))
+				return nil
+			})
+			kube.MockUpdate = test.NewMockUpdateFn(nil, func(obj client.Object) error {
+				lateInitialized = lateInitialized || obj.(*categoryv1alpha1.Category).Spec.ForProvider.Position != 0
+				return nil
+			})
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+
+			mc := magento.NewClient(ts.URL, "token")
+			mc.Path = api + separator + apiVersion + separator + "categories"
+			mc.Key = "category"
+			r := managed.NewReconciler(&fake.Manager{Client: kube, Scheme: s},
+				resource.ManagedKind(categoryv1alpha1.CategoryGroupVersionKind),
+				managed.WithExternalConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					return &external{service: &MagentoService{client: mc}, config: kindConfigs[categoryv1alpha1.CategoryKind]}, nil
+				})),
+				managed.WithManagementPolicies())
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}}); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.lateInitialized, lateInitialized); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want late initialized, +got late initialized:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLookupAdoption(t *testing.T) {
+	// Resources which were reconciled before have their finalizer and
+	// external name, so the reconciler only saves them if the observation
+	// asks for it.
+	finalizers := []string{"finalizer.managedresource.crossplane.io"}
+	customer := func(annotations map[string]string) *customerv1alpha1.Customer {
+		annotations[meta.AnnotationKeyExternalName] = "example"
+		return &customerv1alpha1.Customer{
+			ObjectMeta: metav1.ObjectMeta{Name: "example", Annotations: annotations, Finalizers: finalizers},
+			Spec: customerv1alpha1.CustomerSpec{ForProvider: customerv1alpha1.CustomerParameters{
+				Email: "jane@example.com", WebsiteID: 1, Firstname: "Jane", Lastname: "Doe",
+			}},
+		}
+	}
+	option := func(annotations map[string]string) *productcustomoptionv1alpha1.ProductCustomOption {
+		annotations[meta.AnnotationKeyExternalName] = "example"
+		return &productcustomoptionv1alpha1.ProductCustomOption{
+			ObjectMeta: metav1.ObjectMeta{Name: "example", Annotations: annotations, Finalizers: finalizers},
+			Spec: productcustomoptionv1alpha1.ProductCustomOptionSpec{ForProvider: productcustomoptionv1alpha1.ProductCustomOptionParameters{
+				ProductSku: "tshirt", Title: "Engraving", Type: "field", Price: "5", PriceType: "fixed", MaxCharacters: 20,
+			}},
+		}
+	}
+	options := magentoResources{
+		"/rest/V1/products/tshirt/options": `[{"product_sku":"tshirt","option_id":2,"title":"Gift Wrap","type":"checkbox","is_require":false},` +
+			`{"product_sku":"tshirt","option_id":3,"title":"Engraving","type":"field","sort_order":1,"is_require":false,"price":5,"price_type":"fixed","max_characters":20}]`,
+		"/rest/V1/products/tshirt/options/3": `{"product_sku":"tshirt","option_id":3,"title":"Engraving","type":"field","sort_order":1,"is_require":false,"price":5,"price_type":"fixed","max_characters":20}`,
+	}
+	customers := magentoResources{
+		"/rest/V1/customers/search": `{"items":[{"id":8,"email":"jane@example.com","website_id":1}],"total_count":1}`,
+		"/rest/V1/customers/8":      `{"id":8,"group_id":1,"email":"jane@example.com","firstname":"Jane","lastname":"Doe","website_id":1}`,
+	}
+
+	type args struct {
+		path      string
+		gvk       schema.GroupVersionKind
+		mg        resource.Managed
+		resources magentoResources
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"CustomerAdopted": {
+			reason: "The ID of a customer found by email should be saved, so it is not looked up again.",
+			args: args{
+				path:      "customers",
+				gvk:       customerv1alpha1.CustomerGroupVersionKind,
+				mg:        customer(map[string]string{}),
+				resources: customers,
+			},
+			want: "8",
+		},
+		"CustomerKnown": {
+			reason: "A customer whose ID is saved already should not be saved again.",
+			args: args{
+				path:      "customers",
+				gvk:       customerv1alpha1.CustomerGroupVersionKind,
+				mg:        customer(map[string]string{id: "8"}),
+				resources: customers,
+			},
+		},
+		"ProductCustomOptionAdopted": {
+			reason: "The ID of an option found by title should be saved, so renaming it does not create another option.",
+			args: args{
+				path:      "products/tshirt/options",
+				gvk:       productcustomoptionv1alpha1.ProductCustomOptionGroupVersionKind,
+				mg:        option(map[string]string{}),
+				resources: options,
+			},
+			want: "3",
+		},
+		"ProductCustomOptionKnown": {
+			reason: "An option whose ID is saved already should not be saved again.",
+			args: args{
+				path:      "products/tshirt/options",
+				gvk:       productcustomoptionv1alpha1.ProductCustomOptionGroupVersionKind,
+				mg:        option(map[string]string{id: "3"}),
+				resources: options,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(tc.args.resources)
+			defer ts.Close()
+
+			saved := ""
+			kube := test.NewMockClient()
+			kube.MockGet = test.NewMockGetFn(nil, func(obj client.Object) error {
+				u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.args.mg)
+				if err != nil {
+					return err
+				}
+				return runtime.DefaultUnstructuredConverter.FromUnstructured(u, obj)
+			})
+			kube.MockUpdate = test.NewMockUpdateFn(nil, func(obj client.Object) error {
+				saved = obj.GetAnnotations()[id]
+				return nil
+			})
+			s := runtime.NewScheme()
+			if err := apis.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+
+			r := managed.NewReconciler(&fake.Manager{Client: kube, Scheme: s},
+				resource.ManagedKind(tc.args.gvk),
+				managed.WithExternalConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					return testExternal(ts.URL, tc.args.path, tc.args.gvk.Kind), nil
+				})))
+
+			if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}}); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, saved); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want saved ID, +got saved ID:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
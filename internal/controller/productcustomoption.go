@@ -0,0 +1,56 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	productcustomoptionv1alpha1 "github.com/web-seven/provider-magento/apis/productcustomoption/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotProductCustomOption = "managed resource is not a ProductCustomOption custom resource"
+	errListCustomOptions      = "cannot list product custom options"
+)
+
+// productCustomOptionLookup finds the option of the product with the title of
+// a ProductCustomOption.
+func productCustomOptionLookup(_ context.Context, e *external, mg resource.Managed) (string, error) {
+	cr, ok := mg.(*productcustomoptionv1alpha1.ProductCustomOption)
+	if !ok {
+		return "", errors.New(errNotProductCustomOption)
+	}
+	var options []map[string]interface{}
+	err := magento.Get(e.service.client, &options)
+	if magento.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err, errListCustomOptions)
+	}
+	for _, o := range options {
+		if o["title"] == cr.Spec.ForProvider.Title {
+			return fmt.Sprintf("%v", o[e.service.client.IDKey]), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	productlinksv1alpha1 "github.com/web-seven/provider-magento/apis/productlinks/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotProductLinks  = "managed resource is not a ProductLinks custom resource"
+	errGetLinkTypes     = "cannot get product link types"
+	errUnknownLinkType  = "unknown product link type %q, Magento supports: %s"
+	errGetProductLinks  = "cannot get product links"
+	errSaveProductLinks = "cannot save product links"
+	errDeleteLink       = "cannot delete product link to "
+
+	productLinkTypesPath = "products/links/types"
+)
+
+// productLinks reconcile the products linked to a product as a set.
+var productLinks = &setOps{
+	desired:  productLinksDesired,
+	observed: productLinksObserved,
+	key:      func(en entry) string { return fmt.Sprintf("%v", en["linked_product_sku"]) },
+	save:     productLinksSave,
+	remove:   productLinksDelete,
+	record:   productLinksRecord,
+}
+
+// productLinksDesired returns the declared links of a product, positioned
+// in the order they are listed.
+func productLinksDesired(mg resource.Managed) ([]entry, error) {
+	cr, ok := mg.(*productlinksv1alpha1.ProductLinks)
+	if !ok {
+		return nil, errors.New(errNotProductLinks)
+	}
+	entries := make([]entry, 0, len(cr.Spec.ForProvider.LinkedSkus))
+	for i, sku := range cr.Spec.ForProvider.LinkedSkus {
+		entries = append(entries, entry{"linked_product_sku": sku, "position": i + 1})
+	}
+	return entries, nil
+}
+
+// productLinksObserved returns the links of a product in Magento, after
+// validating the declared link type.
+func productLinksObserved(_ context.Context, e *external, mg resource.Managed) ([]entry, error) {
+	cr, ok := mg.(*productlinksv1alpha1.ProductLinks)
+	if !ok {
+		return nil, errors.New(errNotProductLinks)
+	}
+	if err := validateLinkType(e, cr.Spec.ForProvider.LinkType); err != nil {
+		return nil, err
+	}
+	var entries []entry
+	if err := magento.Get(e.service.client, &entries); err != nil {
+		return nil, errors.Wrap(err, errGetProductLinks)
+	}
+	return entries, nil
+}
+
+// validateLinkType returns an error if Magento does not support the link
+// type. Magento lists link types by a numeric code and the name used in
+// link paths and bodies.
+func validateLinkType(e *external, linkType string) error {
+	var types []struct {
+		Name string `json:"name"`
+	}
+	if err := magento.Get(e.endpoint(productLinkTypesPath), &types); err != nil {
+		return errors.Wrap(err, errGetLinkTypes)
+	}
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		if t.Name == linkType {
+			return nil
+		}
+		names = append(names, t.Name)
+	}
+	return errors.Errorf(errUnknownLinkType, linkType, strings.Join(names, ", "))
+}
+
+// productLinksSave adds links to a product, or changes their position.
+func productLinksSave(_ context.Context, e *external, mg resource.Managed, entries []entry) error {
+	cr, ok := mg.(*productlinksv1alpha1.ProductLinks)
+	if !ok {
+		return errors.New(errNotProductLinks)
+	}
+	items := make([]map[string]interface{}, 0, len(entries))
+	for _, en := range entries {
+		items = append(items, map[string]interface{}{
+			"sku":                cr.Spec.ForProvider.Sku,
+			"link_type":          cr.Spec.ForProvider.LinkType,
+			"linked_product_sku": en["linked_product_sku"],
+			"position":           en["position"],
+		})
+	}
+	path := "products/" + url.PathEscape(cr.Spec.ForProvider.Sku) + "/links"
+	return errors.Wrap(magento.Post(e.endpoint(path), map[string]interface{}{"items": items}, nil), errSaveProductLinks)
+}
+
+// productLinksDelete removes links from a product.
+func productLinksDelete(_ context.Context, e *external, _ resource.Managed, entries []entry) error {
+	for _, en := range entries {
+		sku := fmt.Sprintf("%v", en["linked_product_sku"])
+		if err := magento.DeleteResourceByID(e.service.client, sku); err != nil {
+			return errors.Wrap(err, errDeleteLink+sku)
+		}
+	}
+	return nil
+}
+
+// productLinksRecord stores the linked products in atProvider, in the order
+// of their position.
+func productLinksRecord(mg resource.Managed, entries []entry) error {
+	cr, ok := mg.(*productlinksv1alpha1.ProductLinks)
+	if !ok {
+		return errors.New(errNotProductLinks)
+	}
+	position := func(en entry) int {
+		p, _ := strconv.Atoi(fmt.Sprintf("%v", en["position"]))
+		return p
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return position(entries[i]) < position(entries[j])
+	})
+	skus := make([]string, 0, len(entries))
+	for _, en := range entries {
+		skus = append(skus, fmt.Sprintf("%v", en["linked_product_sku"]))
+	}
+	cr.Status.AtProvider.LinkedSkus = skus
+	return nil
+}
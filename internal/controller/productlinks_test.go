@@ -0,0 +1,133 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	productlinksv1alpha1 "github.com/web-seven/provider-magento/apis/productlinks/v1alpha1"
+)
+
+func TestProductLinks(t *testing.T) {
+	type args struct {
+		linkType   string
+		linkedSkus []string
+	}
+
+	type want struct {
+		upToDate   bool
+		err        error
+		writes     []string
+		linkedSkus []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"UpToDate": {
+			reason: "A product with the declared links in their order should be up to date.",
+			args:   args{linkType: "related", linkedSkus: []string{"cap", "hoodie"}},
+			want:   want{upToDate: true, linkedSkus: []string{"cap", "hoodie"}},
+		},
+		"Added": {
+			reason: "A declared link which is missing should be saved as an item with the link type.",
+			args:   args{linkType: "related", linkedSkus: []string{"cap", "hoodie", "socks"}},
+			want: want{
+				writes:     []string{`POST /rest/V1/products/tshirt/links {"items":[{"link_type":"related","linked_product_sku":"socks","position":3,"sku":"tshirt"}]}`},
+				linkedSkus: []string{"cap", "hoodie"},
+			},
+		},
+		"Reordered": {
+			reason: "Links whose declared order changed should be saved at their new positions.",
+			args:   args{linkType: "related", linkedSkus: []string{"hoodie", "cap"}},
+			want: want{
+				writes: []string{
+					`POST /rest/V1/products/tshirt/links {"items":[` +
+						`{"link_type":"related","linked_product_sku":"hoodie","position":1,"sku":"tshirt"},` +
+						`{"link_type":"related","linked_product_sku":"cap","position":2,"sku":"tshirt"}]}`,
+				},
+				linkedSkus: []string{"cap", "hoodie"},
+			},
+		},
+		"Removed": {
+			reason: "A link which is not declared should be removed.",
+			args:   args{linkType: "related", linkedSkus: []string{"cap"}},
+			want: want{
+				writes:     []string{`DELETE /rest/V1/products/tshirt/links/related/hoodie`},
+				linkedSkus: []string{"cap", "hoodie"},
+			},
+		},
+		"UnknownLinkType": {
+			reason: "A link type Magento does not support should be rejected before any link is observed.",
+			args:   args{linkType: "similar", linkedSkus: []string{"cap"}},
+			want:   want{err: errors.Errorf(errUnknownLinkType, "similar", "related, upsell, crosssell, associated")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := &magentoWrites{resources: magentoResources{
+				"/rest/V1/products/links/types": `[{"code":1,"name":"related"},{"code":4,"name":"upsell"},{"code":5,"name":"crosssell"},{"code":3,"name":"associated"}]`,
+				"/rest/V1/products/tshirt/links/related": `[{"sku":"tshirt","link_type":"related","linked_product_sku":"hoodie","linked_product_type":"simple","position":2},` +
+					`{"sku":"tshirt","link_type":"related","linked_product_sku":"cap","linked_product_type":"simple","position":1}]`,
+			}}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			e := testExternal(ts.URL, "products/tshirt/links/"+tc.args.linkType, productlinksv1alpha1.ProductLinksKind)
+			cr := &productlinksv1alpha1.ProductLinks{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "tshirt"}},
+				Spec: productlinksv1alpha1.ProductLinksSpec{ForProvider: productlinksv1alpha1.ProductLinksParameters{
+					Sku:        "tshirt",
+					LinkType:   tc.args.linkType,
+					LinkedSkus: tc.args.linkedSkus,
+				}},
+			}
+
+			// Like the managed reconciler, only update sets which were
+			// observed not to be up to date.
+			o, err := e.Observe(context.Background(), cr)
+			if err == nil && !o.ResourceUpToDate {
+				if _, err := e.Update(context.Background(), cr); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, o.ResourceUpToDate); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.linkedSkus, cr.Status.AtProvider.LinkedSkus); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want linked SKUs, +got linked SKUs:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	productmediav1alpha1 "github.com/web-seven/provider-magento/apis/productmedia/v1alpha1"
+)
+
+const (
+	errNotProductMedia = "managed resource is not a ProductMedia custom resource"
+	errGetMediaConfig  = "cannot get media content ConfigMap"
+	errGetMediaSecret  = "cannot get media content Secret"
+	errFetchMedia      = "cannot fetch media content"
+	errNoMediaKey      = "media content source has no key "
+	errNoMediaSource   = "media content source is not set"
+	errMediaTooLarge   = "media content exceeds the size limit"
+
+	// maxMediaSize limits the size of media content fetched over HTTP.
+	maxMediaSize = 16 << 20
+)
+
+// mediaClient fetches media content over HTTP without waiting on slow
+// servers indefinitely.
+var mediaClient = &http.Client{Timeout: time.Minute}
+
+// mediaContent is the binary content of a media gallery entry, along with
+// the URL and entity tag of content fetched over HTTP.
+type mediaContent struct {
+	data []byte
+	name string
+	url  string
+	etag string
+}
+
+// hash returns the SHA-256 hash of the content.
+func (m mediaContent) hash() string {
+	sum := sha256.Sum256(m.data)
+	return hex.EncodeToString(sum[:])
+}
+
+// productMediaContent loads the content of a ProductMedia from its source.
+// If only changed content is asked for, content fetched from the URL it was
+// last uploaded from is only downloaded again if it changed; nil is returned
+// if it did not.
+func productMediaContent(ctx context.Context, e *external, cr *productmediav1alpha1.ProductMedia, ifChanged bool) (*mediaContent, error) {
+	src := cr.Spec.ForProvider.ContentFrom
+	m := &mediaContent{}
+	switch {
+	case src.ConfigMapKeyRef != nil:
+		ref := src.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetMediaConfig)
+		}
+		if b, ok := cm.BinaryData[ref.Key]; ok {
+			m.data = b
+		} else if s, ok := cm.Data[ref.Key]; ok {
+			m.data = []byte(s)
+		} else {
+			return nil, errors.New(errNoMediaKey + ref.Key)
+		}
+		m.name = ref.Key
+	case src.SecretKeyRef != nil:
+		ref := src.SecretKeyRef
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetMediaSecret)
+		}
+		b, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.New(errNoMediaKey + ref.Key)
+		}
+		m.data = b
+		m.name = ref.Key
+	case src.URL != "":
+		at := cr.Status.AtProvider
+		etag := ""
+		if ifChanged && at.ContentHash != "" && at.ContentURL == src.URL {
+			etag = at.ContentETag
+		}
+		b, etag, err := fetchMedia(ctx, src.URL, etag)
+		if err != nil {
+			return nil, errors.Wrap(err, errFetchMedia)
+		}
+		if b == nil {
+			return nil, nil
+		}
+		m.data, m.url, m.etag = b, src.URL, etag
+		if u, err := url.Parse(src.URL); err == nil {
+			m.name = path.Base(u.Path)
+		}
+	default:
+		return nil, errors.New(errNoMediaSource)
+	}
+	if cr.Spec.ForProvider.FileName != "" {
+		m.name = cr.Spec.ForProvider.FileName
+	}
+	return m, nil
+}
+
+// fetchMedia downloads media content over HTTP, up to maxMediaSize. Given the
+// entity tag of content downloaded before, it returns no content if the
+// content did not change. The entity tag of downloaded content is returned
+// along with it.
+func fetchMedia(ctx context.Context, u, etag string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := mediaClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close() //nolint:errcheck // Nothing to do about a failed close.
+	if resp.StatusCode == http.StatusNotModified && etag != "" {
+		return nil, etag, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", errors.New(resp.Status)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxMediaSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(b) > maxMediaSize {
+		return nil, "", errors.New(errMediaTooLarge)
+	}
+	return b, resp.Header.Get("ETag"), nil
+}
+
+// setMediaContent adds the content to the media gallery entry of a request
+// body, base64 encoded.
+func setMediaContent(e *external, body map[string]interface{}, m *mediaContent) {
+	entry, ok := body[e.service.client.Key].(map[string]interface{})
+	if !ok {
+		return
+	}
+	entry["content"] = map[string]interface{}{
+		"base64EncodedData": base64.StdEncoding.EncodeToString(m.data),
+		"type":              http.DetectContentType(m.data),
+		"name":              m.name,
+	}
+}
+
+// productMediaCreateBody uploads the content of a new ProductMedia.
+func productMediaCreateBody(ctx context.Context, e *external, mg resource.Managed, body map[string]interface{}) error {
+	cr, ok := mg.(*productmediav1alpha1.ProductMedia)
+	if !ok {
+		return errors.New(errNotProductMedia)
+	}
+	m, err := productMediaContent(ctx, e, cr, false)
+	if err != nil {
+		return err
+	}
+	setMediaContent(e, body, m)
+	return nil
+}
+
+// productMediaUpdateBody identifies the updated entry and uploads the
+// content again if it changed since it was last uploaded.
+func productMediaUpdateBody(ctx context.Context, e *external, mg resource.Managed, body map[string]interface{}) error {
+	cr, ok := mg.(*productmediav1alpha1.ProductMedia)
+	if !ok {
+		return errors.New(errNotProductMedia)
+	}
+	if err := bodyID(ctx, e, mg, body); err != nil {
+		return err
+	}
+	m, err := productMediaContent(ctx, e, cr, true)
+	if err != nil {
+		return err
+	}
+	if m != nil && m.hash() != cr.Status.AtProvider.ContentHash {
+		setMediaContent(e, body, m)
+	}
+	return nil
+}
+
+// recordMediaContent records the hash of the uploaded content, along with
+// where it was fetched from.
+func recordMediaContent(cr *productmediav1alpha1.ProductMedia, m *mediaContent) {
+	cr.Status.AtProvider.ContentHash = m.hash()
+	cr.Status.AtProvider.ContentURL = m.url
+	cr.Status.AtProvider.ContentETag = m.etag
+}
+
+// productMediaUpdated records the hash of the uploaded content.
+func productMediaUpdated(ctx context.Context, e *external, mg resource.Managed) error {
+	cr, ok := mg.(*productmediav1alpha1.ProductMedia)
+	if !ok {
+		return errors.New(errNotProductMedia)
+	}
+	m, err := productMediaContent(ctx, e, cr, true)
+	if err != nil || m == nil {
+		return err
+	}
+	recordMediaContent(cr, m)
+	return nil
+}
+
+// productMediaObserveContent reports whether the content of a ProductMedia
+// changed since it was uploaded. The hash of content uploaded on creation
+// is recorded by the first observation.
+func productMediaObserveContent(ctx context.Context, e *external, mg resource.Managed, _ map[string]interface{}) (bool, managed.ConnectionDetails, error) {
+	cr, ok := mg.(*productmediav1alpha1.ProductMedia)
+	if !ok {
+		return false, nil, errors.New(errNotProductMedia)
+	}
+	m, err := productMediaContent(ctx, e, cr, true)
+	if err != nil {
+		return false, nil, err
+	}
+	if m == nil {
+		return true, nil, nil
+	}
+	if cr.Status.AtProvider.ContentHash == "" || m.hash() == cr.Status.AtProvider.ContentHash {
+		recordMediaContent(cr, m)
+	}
+	return m.hash() == cr.Status.AtProvider.ContentHash, nil, nil
+}
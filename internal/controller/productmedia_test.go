@@ -0,0 +1,212 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	productmediav1alpha1 "github.com/web-seven/provider-magento/apis/productmedia/v1alpha1"
+)
+
+// mediaServer fakes a server of media content, which honours If-None-Match
+// and counts the downloads of content.
+type mediaServer struct {
+	downloads int
+}
+
+func (m *mediaServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/image.png":
+		w.Header().Set("ETag", `"v2"`)
+		if r.Header.Get("If-None-Match") == `"v2"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		m.downloads++
+		_, _ = w.Write([]byte("image"))
+	case "/large.png":
+		m.downloads++
+		_, _ = w.Write([]byte(strings.Repeat("x", maxMediaSize+1)))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestFetchMedia(t *testing.T) {
+	type want struct {
+		data []byte
+		etag string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		path   string
+		etag   string
+		want   want
+	}{
+		"Download": {
+			reason: "Content should be downloaded along with its entity tag.",
+			path:   "/image.png",
+			want:   want{data: []byte("image"), etag: `"v2"`},
+		},
+		"Changed": {
+			reason: "Content should be downloaded again if its entity tag changed.",
+			path:   "/image.png",
+			etag:   `"v1"`,
+			want:   want{data: []byte("image"), etag: `"v2"`},
+		},
+		"NotModified": {
+			reason: "No content should be returned if its entity tag did not change.",
+			path:   "/image.png",
+			etag:   `"v2"`,
+			want:   want{etag: `"v2"`},
+		},
+		"TooLarge": {
+			reason: "Content exceeding the size limit should not be returned.",
+			path:   "/large.png",
+			want:   want{err: errors.New(errMediaTooLarge)},
+		},
+		"NotFound": {
+			reason: "A failed download should return an error.",
+			path:   "/missing.png",
+			want:   want{err: errors.New("404 Not Found")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ts := httptest.NewServer(&mediaServer{})
+			defer ts.Close()
+
+			data, etag, err := fetchMedia(context.Background(), ts.URL+tc.path, tc.etag)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nfetchMedia(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.data, data); diff != "" {
+				t.Errorf("\n%s\nfetchMedia(...): -want data, +got data:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.etag, etag); diff != "" {
+				t.Errorf("\n%s\nfetchMedia(...): -want etag, +got etag:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProductMediaObserveContent(t *testing.T) {
+	image := mediaContent{data: []byte("image")}
+
+	type want struct {
+		upToDate   bool
+		downloads  int
+		atProvider productmediav1alpha1.ProductMediaObservation
+	}
+
+	cases := map[string]struct {
+		reason     string
+		path       string
+		atProvider productmediav1alpha1.ProductMediaObservation
+		want       want
+	}{
+		"FirstObservation": {
+			reason: "The content uploaded on creation should be downloaded and recorded.",
+			path:   "/image.png",
+			want: want{
+				upToDate:   true,
+				downloads:  1,
+				atProvider: productmediav1alpha1.ProductMediaObservation{ContentHash: image.hash(), ContentURL: "/image.png", ContentETag: `"v2"`},
+			},
+		},
+		"Unchanged": {
+			reason: "Content which did not change since it was uploaded should not be downloaded again.",
+			path:   "/image.png",
+			atProvider: productmediav1alpha1.ProductMediaObservation{
+				ContentHash: image.hash(), ContentURL: "/image.png", ContentETag: `"v2"`,
+			},
+			want: want{
+				upToDate:   true,
+				atProvider: productmediav1alpha1.ProductMediaObservation{ContentHash: image.hash(), ContentURL: "/image.png", ContentETag: `"v2"`},
+			},
+		},
+		"Changed": {
+			reason: "Content which changed since it was uploaded should not be up to date.",
+			path:   "/image.png",
+			atProvider: productmediav1alpha1.ProductMediaObservation{
+				ContentHash: "old", ContentURL: "/image.png", ContentETag: `"v1"`,
+			},
+			want: want{
+				downloads:  1,
+				atProvider: productmediav1alpha1.ProductMediaObservation{ContentHash: "old", ContentURL: "/image.png", ContentETag: `"v1"`},
+			},
+		},
+		"URLChanged": {
+			reason: "Content of another URL than it was uploaded from should be downloaded.",
+			path:   "/image.png",
+			atProvider: productmediav1alpha1.ProductMediaObservation{
+				ContentHash: "old", ContentURL: "/old.png", ContentETag: `"v2"`,
+			},
+			want: want{
+				downloads:  1,
+				atProvider: productmediav1alpha1.ProductMediaObservation{ContentHash: "old", ContentURL: "/old.png", ContentETag: `"v2"`},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := &mediaServer{}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			// Recorded URLs are relative to the fake server.
+			at := tc.atProvider
+			if at.ContentURL != "" {
+				at.ContentURL = ts.URL + at.ContentURL
+			}
+			cr := &productmediav1alpha1.ProductMedia{
+				Spec: productmediav1alpha1.ProductMediaSpec{ForProvider: productmediav1alpha1.ProductMediaParameters{
+					ContentFrom: productmediav1alpha1.MediaContentSource{URL: ts.URL + tc.path},
+				}},
+				Status: productmediav1alpha1.ProductMediaStatus{AtProvider: at},
+			}
+			upToDate, _, err := productMediaObserveContent(context.Background(), &external{}, cr, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("\n%s\nproductMediaObserveContent(...): -want up to date, +got up to date:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.downloads, srv.downloads); diff != "" {
+				t.Errorf("\n%s\nproductMediaObserveContent(...): -want downloads, +got downloads:\n%s\n", tc.reason, diff)
+			}
+			got := cr.Status.AtProvider
+			got.ContentURL = strings.TrimPrefix(got.ContentURL, ts.URL)
+			if diff := cmp.Diff(tc.want.atProvider, got); diff != "" {
+				t.Errorf("\n%s\nproductMediaObserveContent(...): -want atProvider, +got atProvider:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	producttierpricesv1alpha1 "github.com/web-seven/provider-magento/apis/producttierprices/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotProductTierPrices = "managed resource is not a ProductTierPrices custom resource"
+	errGetTierPrices        = "cannot get tier prices"
+	errSaveTierPrices       = "cannot save tier prices"
+	errDeleteTierPrices     = "cannot delete tier prices"
+
+	tierPricesPath            = "products/tier-prices"
+	tierPricesInformationPath = "products/tier-prices-information"
+	tierPricesDeletePath      = "products/tier-prices-delete"
+
+	// Magento customer group code of tier prices for all customer groups.
+	allCustomerGroups = "ALL GROUPS"
+	tierPriceFixed    = "fixed"
+)
+
+// tierPrices reconcile the tier prices of a product as a set.
+var tierPrices = &setOps{
+	desired:  tierPricesDesired,
+	observed: tierPricesObserved,
+	key:      tierPriceKey,
+	save: func(_ context.Context, e *external, _ resource.Managed, entries []entry) error {
+		return errors.Wrap(postTierPrices(e, tierPricesPath, entries), errSaveTierPrices)
+	},
+	remove: func(_ context.Context, e *external, _ resource.Managed, entries []entry) error {
+		return errors.Wrap(postTierPrices(e, tierPricesDeletePath, entries), errDeleteTierPrices)
+	},
+	record: tierPricesRecord,
+}
+
+// tierPricesDesired returns the declared tier prices of a product.
+func tierPricesDesired(mg resource.Managed) ([]entry, error) {
+	cr, ok := mg.(*producttierpricesv1alpha1.ProductTierPrices)
+	if !ok {
+		return nil, errors.New(errNotProductTierPrices)
+	}
+	entries := make([]entry, 0, len(cr.Spec.ForProvider.Prices))
+	for _, p := range cr.Spec.ForProvider.Prices {
+		qty, err := strconv.ParseFloat(p.Quantity, 64)
+		if err != nil {
+			return nil, err
+		}
+		price, err := strconv.ParseFloat(p.Price, 64)
+		if err != nil {
+			return nil, err
+		}
+		group := tierPriceGroup(p.CustomerGroup)
+		if group == "" {
+			group = allCustomerGroups
+		}
+		priceType := p.PriceType
+		if priceType == "" {
+			priceType = tierPriceFixed
+		}
+		entries = append(entries, entry{
+			"sku":            cr.Spec.ForProvider.Sku,
+			"quantity":       qty,
+			"customer_group": group,
+			"website_id":     p.WebsiteID,
+			"price":          price,
+			"price_type":     priceType,
+		})
+	}
+	return entries, nil
+}
+
+// tierPricesObserved returns the tier prices of a product in Magento.
+func tierPricesObserved(_ context.Context, e *external, mg resource.Managed) ([]entry, error) {
+	cr, ok := mg.(*producttierpricesv1alpha1.ProductTierPrices)
+	if !ok {
+		return nil, errors.New(errNotProductTierPrices)
+	}
+	var entries []entry
+	body := map[string]interface{}{"skus": []string{cr.Spec.ForProvider.Sku}}
+	if err := magento.Post(e.endpoint(tierPricesInformationPath), body, &entries); err != nil {
+		return nil, errors.Wrap(err, errGetTierPrices)
+	}
+	for _, en := range entries {
+		en["customer_group"] = tierPriceGroup(en["customer_group"])
+	}
+	return entries, nil
+}
+
+// tierPriceGroup normalises the customer group code of a tier price, which
+// Magento matches regardless of case.
+func tierPriceGroup(group interface{}) string {
+	return strings.ToUpper(fmt.Sprintf("%v", group))
+}
+
+// tierPriceKey identifies a tier price by website, customer group and
+// quantity, which Magento allows one price for.
+func tierPriceKey(en entry) string {
+	qty, _ := strconv.ParseFloat(fmt.Sprintf("%v", en["quantity"]), 64)
+	return fmt.Sprintf("%v/%s/%g", en["website_id"], tierPriceGroup(en["customer_group"]), qty)
+}
+
+// postTierPrices sends tier prices to a bulk endpoint, which responds with
+// the prices it failed to process.
+func postTierPrices(e *external, path string, entries []entry) error {
+	var failures []struct {
+		Message string `json:"message"`
+	}
+	if err := magento.Post(e.endpoint(path), map[string]interface{}{"prices": entries}, &failures); err != nil {
+		return err
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(failures))
+	for _, f := range failures {
+		messages = append(messages, f.Message)
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// tierPricesRecord stores the tier prices of a product in atProvider.
+func tierPricesRecord(mg resource.Managed, entries []entry) error {
+	cr, ok := mg.(*producttierpricesv1alpha1.ProductTierPrices)
+	if !ok {
+		return errors.New(errNotProductTierPrices)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return tierPriceKey(entries[i]) < tierPriceKey(entries[j])
+	})
+	prices := make([]producttierpricesv1alpha1.TierPrice, 0, len(entries))
+	for _, en := range entries {
+		website, _ := strconv.Atoi(fmt.Sprintf("%v", en["website_id"]))
+		prices = append(prices, producttierpricesv1alpha1.TierPrice{
+			Quantity:      fmt.Sprintf("%v", en["quantity"]),
+			CustomerGroup: fmt.Sprintf("%v", en["customer_group"]),
+			WebsiteID:     website,
+			Price:         fmt.Sprintf("%v", en["price"]),
+			PriceType:     fmt.Sprintf("%v", en["price_type"]),
+		})
+	}
+	cr.Status.AtProvider.Prices = prices
+	return nil
+}
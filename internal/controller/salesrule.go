@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	salesrulev1alpha1 "github.com/web-seven/provider-magento/apis/salesrule/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotSalesRule    = "managed resource is not a SalesRule custom resource"
+	errListCoupons     = "cannot list generated coupons"
+	errGenerateCoupons = "cannot generate coupons"
+
+	// Magento coupon type of generated coupon codes.
+	couponTypeGenerated = "1"
+
+	// connectionKeyCouponCodes holds the generated coupon codes, one per line.
+	connectionKeyCouponCodes = "couponCodes"
+)
+
+// generatedCoupons returns the codes of the coupons generated for a rule.
+func generatedCoupons(e *external, ruleID string) ([]string, error) {
+	items, err := magento.SearchResources(e.endpoint("coupons/search"), map[string]string{
+		"rule_id": ruleID,
+		"type":    couponTypeGenerated,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListCoupons)
+	}
+	codes := make([]string, 0, len(items))
+	for _, item := range items {
+		if code, ok := item["code"].(string); ok {
+			codes = append(codes, code)
+		}
+	}
+	return codes, nil
+}
+
+// salesRuleObserveCoupons publishes the coupon codes generated for a
+// SalesRule and reports whether all configured codes were generated.
+func salesRuleObserveCoupons(_ context.Context, e *external, mg resource.Managed, _ map[string]interface{}) (bool, managed.ConnectionDetails, error) {
+	cr, ok := mg.(*salesrulev1alpha1.SalesRule)
+	if !ok {
+		return false, nil, errors.New(errNotSalesRule)
+	}
+	gen := cr.Spec.ForProvider.CouponGeneration
+	if gen == nil {
+		return true, nil, nil
+	}
+	codes, err := generatedCoupons(e, mg.GetAnnotations()[id])
+	if err != nil {
+		return false, nil, err
+	}
+	cr.Status.AtProvider.GeneratedCoupons = len(codes)
+	return len(codes) >= gen.Quantity, managed.ConnectionDetails{
+		connectionKeyCouponCodes: []byte(strings.Join(codes, "\n")),
+	}, nil
+}
+
+// salesRuleGenerateCoupons generates the coupon codes still missing for a
+// SalesRule. They are published on the next observation.
+func salesRuleGenerateCoupons(_ context.Context, e *external, mg resource.Managed) error {
+	cr, ok := mg.(*salesrulev1alpha1.SalesRule)
+	if !ok {
+		return errors.New(errNotSalesRule)
+	}
+	gen := cr.Spec.ForProvider.CouponGeneration
+	if gen == nil || cr.Status.AtProvider.GeneratedCoupons >= gen.Quantity {
+		return nil
+	}
+	spec := map[string]interface{}{
+		"couponSpec": map[string]interface{}{
+			"rule_id":            mg.GetAnnotations()[id],
+			"quantity":           gen.Quantity - cr.Status.AtProvider.GeneratedCoupons,
+			"length":             gen.Length,
+			"format":             gen.Format,
+			"prefix":             gen.Prefix,
+			"suffix":             gen.Suffix,
+			"delimiter":          gen.Delimiter,
+			"delimiter_at_every": gen.DelimiterAtEvery,
+		},
+	}
+	var codes []string
+	if err := magento.Post(e.endpoint("coupons/generate"), spec, &codes); err != nil {
+		return errors.Wrap(err, errGenerateCoupons)
+	}
+	cr.Status.AtProvider.GeneratedCoupons += len(codes)
+	return nil
+}
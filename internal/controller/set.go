@@ -0,0 +1,153 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// An entry of a set, by field name as used by Magento.
+type entry = map[string]interface{}
+
+// setOps reconcile kinds declaring the complete set of some Magento entries,
+// like the tier prices of a product. Such kinds own every entry of their
+// set: entries which are not declared are removed.
+type setOps struct {
+	// desired returns the declared entries.
+	desired func(mg resource.Managed) ([]entry, error)
+	// observed returns the entries in Magento.
+	observed func(ctx context.Context, e *external, mg resource.Managed) ([]entry, error)
+	// key identifies an entry within the set.
+	key func(en entry) string
+	// save adds or changes entries.
+	save func(ctx context.Context, e *external, mg resource.Managed, entries []entry) error
+	// remove removes entries.
+	remove func(ctx context.Context, e *external, mg resource.Managed, entries []entry) error
+	// record stores the observed entries in atProvider.
+	record func(mg resource.Managed, entries []entry) error
+}
+
+// setDiff returns the declared entries which are missing or differ from the
+// observed ones, along with the observed entries which are not declared.
+func setDiff(ops *setOps, desired, observed []entry) ([]entry, []entry) {
+	byKey := make(map[string]entry, len(observed))
+	for _, en := range observed {
+		byKey[ops.key(en)] = en
+	}
+	var save []entry
+	for _, en := range desired {
+		k := ops.key(en)
+		if o, ok := byKey[k]; !ok || !sameEntry(en, o) {
+			save = append(save, en)
+		}
+		delete(byKey, k)
+	}
+	var remove []entry
+	for _, en := range observed {
+		if _, ok := byKey[ops.key(en)]; ok {
+			remove = append(remove, en)
+		}
+	}
+	return save, remove
+}
+
+// sameEntry returns true if all declared fields have the observed value.
+func sameEntry(desired, observed entry) bool {
+	for field, v := range desired {
+		if !sameValue(v, observed[field]) {
+			return false
+		}
+	}
+	return true
+}
+
+// observeSet compares the declared entries of the managed resource with the
+// entries in Magento. The set exists once it was created and as long as it
+// has entries, so a deleted set without entries is gone.
+func (c *external) observeSet(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	ops := c.config.set
+	observed, err := ops.observed(ctx, c, mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	created := mg.GetAnnotations()[id] != "" && !meta.WasDeleted(mg)
+	if len(observed) == 0 && !created {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err := ops.record(mg, observed); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	desired, err := ops.desired(mg)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	save, remove := setDiff(ops, desired, observed)
+	mg.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  len(save) == 0 && len(remove) == 0,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+// applySet converges the entries in Magento to the declared entries and
+// records the identity of the set as external ID.
+func (c *external) applySet(ctx context.Context, mg resource.Managed) error {
+	ops := c.config.set
+	desired, err := ops.desired(mg)
+	if err != nil {
+		return err
+	}
+	observed, err := ops.observed(ctx, c, mg)
+	if err != nil {
+		return err
+	}
+	save, remove := setDiff(ops, desired, observed)
+	if len(remove) > 0 {
+		if err := ops.remove(ctx, c, mg, remove); err != nil {
+			return err
+		}
+	}
+	if len(save) > 0 {
+		if err := ops.save(ctx, c, mg, save); err != nil {
+			return err
+		}
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return err
+	}
+	meta.AddAnnotations(mg, map[string]string{id: c.externalID(mg, u)})
+	return nil
+}
+
+// deleteSet removes all entries of the set.
+func (c *external) deleteSet(ctx context.Context, mg resource.Managed) error {
+	ops := c.config.set
+	observed, err := ops.observed(ctx, c, mg)
+	if err != nil || len(observed) == 0 {
+		return err
+	}
+	return ops.remove(ctx, c, mg, observed)
+}
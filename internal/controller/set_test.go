@@ -0,0 +1,196 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	producttierpricesv1alpha1 "github.com/web-seven/provider-magento/apis/producttierprices/v1alpha1"
+)
+
+// magentoWrites fakes Magento responding to GET requests like
+// magentoResources, while write requests succeed with the response, or true,
+// and are recorded along with their escaped path and body.
+type magentoWrites struct {
+	resources magentoResources
+	response  string
+	writes    []string
+}
+
+func (m *magentoWrites) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		m.resources.ServeHTTP(w, r)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	m.writes = append(m.writes, strings.TrimSpace(r.Method+" "+r.URL.EscapedPath()+" "+string(body)))
+	if m.response == "" {
+		fmt.Fprint(w, `true`)
+		return
+	}
+	fmt.Fprint(w, m.response)
+}
+
+func TestSetDiff(t *testing.T) {
+	price := func(group string, qty, price interface{}) entry {
+		return entry{
+			"sku":            "tshirt",
+			"quantity":       qty,
+			"customer_group": group,
+			"website_id":     0,
+			"price":          price,
+			"price_type":     tierPriceFixed,
+		}
+	}
+
+	type args struct {
+		desired  []entry
+		observed []entry
+	}
+
+	type want struct {
+		save   []entry
+		remove []entry
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Empty": {
+			reason: "Nothing should be saved or removed if no entries are declared or observed.",
+			args:   args{},
+			want:   want{},
+		},
+		"Same": {
+			reason: "Declared entries having the observed values should not be saved again.",
+			args: args{
+				desired:  []entry{price("RETAIL", 10.0, 9.5)},
+				observed: []entry{price("RETAIL", json.Number("10"), json.Number("9.50"))},
+			},
+			want: want{},
+		},
+		"Missing": {
+			reason: "Declared entries which are not observed should be saved.",
+			args: args{
+				desired:  []entry{price("RETAIL", 10.0, 9.5), price(allCustomerGroups, 5.0, 9.9)},
+				observed: []entry{price("RETAIL", json.Number("10"), json.Number("9.5"))},
+			},
+			want: want{save: []entry{price(allCustomerGroups, 5.0, 9.9)}},
+		},
+		"Changed": {
+			reason: "Declared entries whose observed value differs should be saved.",
+			args: args{
+				desired:  []entry{price("RETAIL", 10.0, 8.5)},
+				observed: []entry{price("RETAIL", json.Number("10"), json.Number("9.5"))},
+			},
+			want: want{save: []entry{price("RETAIL", 10.0, 8.5)}},
+		},
+		"Undeclared": {
+			reason: "Observed entries which are not declared should be removed.",
+			args: args{
+				desired:  []entry{price("RETAIL", 10.0, 9.5)},
+				observed: []entry{price("RETAIL", json.Number("10"), json.Number("9.5")), price("RETAIL", json.Number("20"), json.Number("9"))},
+			},
+			want: want{remove: []entry{price("RETAIL", json.Number("20"), json.Number("9"))}},
+		},
+		"GroupCase": {
+			reason: "Entries should be identified by their customer group regardless of case.",
+			args: args{
+				desired:  []entry{price("RETAIL", 10.0, 9.5)},
+				observed: []entry{price("Retail", json.Number("10"), json.Number("8"))},
+			},
+			want: want{save: []entry{price("RETAIL", 10.0, 9.5)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			save, remove := setDiff(tierPrices, tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.save, save); diff != "" {
+				t.Errorf("\n%s\nsetDiff(...): -want save, +got save:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("\n%s\nsetDiff(...): -want remove, +got remove:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveTierPrices(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/V1/"+tierPricesInformationPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[{"sku":"tshirt","quantity":10,"customer_group":"Retail","website_id":0,"price":9.5,"price_type":"fixed"}]`)
+	}))
+	defer ts.Close()
+
+	cases := map[string]struct {
+		reason string
+		group  string
+		price  string
+		want   managed.ExternalObservation
+	}{
+		"GroupCase": {
+			reason: "A tier price declaring its customer group in another case than Magento returns it should be up to date.",
+			group:  "retail",
+			price:  "9.50",
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+		},
+		"PriceChanged": {
+			reason: "A tier price whose declared price changed should not be up to date.",
+			group:  "RETAIL",
+			price:  "8",
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := testExternal(ts.URL, tierPricesPath, producttierpricesv1alpha1.ProductTierPricesKind)
+			cr := &producttierpricesv1alpha1.ProductTierPrices{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{id: "tshirt"}},
+				Spec: producttierpricesv1alpha1.ProductTierPricesSpec{ForProvider: producttierpricesv1alpha1.ProductTierPricesParameters{
+					Sku:    "tshirt",
+					Prices: []producttierpricesv1alpha1.TierPrice{{Quantity: "10", CustomerGroup: tc.group, Price: tc.price}},
+				}},
+			}
+			got, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
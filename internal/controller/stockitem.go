@@ -0,0 +1,56 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	stockitemv1alpha1 "github.com/web-seven/provider-magento/apis/stockitem/v1alpha1"
+	magento "github.com/web-seven/provider-magento/internal/client"
+)
+
+const (
+	errNotStockItem  = "managed resource is not a StockItem custom resource"
+	errGetStockItem  = "cannot get stock item of product "
+	errSaveStockItem = "cannot save stock item"
+)
+
+// stockItemSave updates the stock item of the product. Every product has a
+// stock item, so there is nothing to create: the ID of the item is
+// discovered from the product unless it was observed already.
+func stockItemSave(_ context.Context, e *external, mg resource.Managed, body map[string]interface{}) error {
+	cr, ok := mg.(*stockitemv1alpha1.StockItem)
+	if !ok {
+		return errors.New(errNotStockItem)
+	}
+	sku := cr.Spec.ForProvider.Sku
+	itemID := strconv.Itoa(cr.Status.AtProvider.ID)
+	if cr.Status.AtProvider.ID == 0 {
+		item, err := magento.GetResourceByID(e.service.client, sku)
+		if err != nil {
+			return errors.Wrap(err, errGetStockItem+sku)
+		}
+		itemID = item.ID.String()
+	}
+	path := "products/" + url.PathEscape(sku) + "/stockItems/" + itemID
+	return errors.Wrap(magento.Put(e.endpoint(path), body, nil), errSaveStockItem)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 Web Seven license.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	stockitemv1alpha1 "github.com/web-seven/provider-magento/apis/stockitem/v1alpha1"
+)
+
+// magentoStockItems fakes the stock item endpoints of Magento for a product
+// whose SKU contains a slash, and records write requests along with their
+// bodies.
+type magentoStockItems struct {
+	writes []string
+}
+
+func (m *magentoStockItems) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.EscapedPath()
+	if r.Method != http.MethodGet {
+		body, _ := io.ReadAll(r.Body)
+		m.writes = append(m.writes, r.Method+" "+path+" "+string(body))
+	}
+	switch {
+	case r.Method == http.MethodGet && path == "/rest/V1/stockItems/tshirt%2Fred":
+		fmt.Fprint(w, `{"item_id":7,"product_id":3,"stock_id":1,"qty":10,"is_in_stock":true,"min_sale_qty":1,"use_config_min_sale_qty":true,"backorders":0,"use_config_backorders":true}`)
+	case r.Method == http.MethodPut && path == "/rest/V1/products/tshirt%2Fred/stockItems/7":
+		fmt.Fprint(w, `7`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"No such entity."}`)
+	}
+}
+
+func TestStockItem(t *testing.T) {
+	type want struct {
+		o      managed.ExternalObservation
+		id     int
+		writes []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		qty    string
+		want   want
+	}{
+		"UpToDate": {
+			reason: "A stock item of a SKU with a slash should be observed at its escaped path and be up to date.",
+			qty:    "10.0000",
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: managed.ConnectionDetails{}},
+				id: 7,
+			},
+		},
+		"QtyChanged": {
+			reason: "A stock item whose declared quantity changed should be saved at the escaped path of its product.",
+			qty:    "12",
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: managed.ConnectionDetails{}},
+				id:     7,
+				writes: []string{`PUT /rest/V1/products/tshirt%2Fred/stockItems/7 {"stockItem":{"isInStock":true,"qty":"12"}}`},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			srv := &magentoStockItems{}
+			ts := httptest.NewServer(srv)
+			defer ts.Close()
+
+			e := testExternal(ts.URL, "stockItems", stockitemv1alpha1.StockItemKind)
+			cr := &stockitemv1alpha1.StockItem{
+				Spec: stockitemv1alpha1.StockItemSpec{ForProvider: stockitemv1alpha1.StockItemParameters{
+					Sku: "tshirt/red", Qty: tc.qty, IsInStock: true,
+				}},
+			}
+
+			// Like the managed reconciler, only update stock items which
+			// were observed not to be up to date.
+			o, err := e.Observe(context.Background(), cr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if o.ResourceExists && !o.ResourceUpToDate {
+				if _, err := e.Update(context.Background(), cr); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.id, cr.Status.AtProvider.ID); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want ID, +got ID:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.writes, srv.writes); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want writes, +got writes:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}